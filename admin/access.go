@@ -0,0 +1,117 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"net/http"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Permissions required to view tab and to submit its forms.
+type tabAccess struct {
+	View   string
+	Manage string
+}
+
+// Permissions for every admin tab. Things which aren't tabs (audit log
+// export, status JSON) are checked with their tab's permissions.
+var tabsAccess = map[string]tabAccess{
+	"index":       {users.PermDashboardView, users.PermDashboardView},
+	"status.json": {users.PermDashboardView, users.PermDashboardView},
+	"packages":    {users.PermPackagesView, users.PermPackagesManage},
+	"routing":     {users.PermPackagesView, users.PermPackagesManage},
+	"resolve":     {users.PermPackagesView, users.PermPackagesView},
+	"hooks":       {users.PermPackagesView, users.PermPackagesManage},
+	"webhooks":    {users.PermWebhooksManage, users.PermWebhooksManage},
+	"vulns":       {users.PermPackagesView, users.PermPackagesView},
+	"snapshots":   {users.PermPackagesView, users.PermPackagesManage},
+	"graph":       {users.PermPackagesView, users.PermPackagesView},
+	"users":       {users.PermUsersManage, users.PermUsersManage},
+	"settings":    {users.PermSettingsManage, users.PermSettingsManage},
+	"audit":       {users.PermAuditView, users.PermAuditView},
+}
+
+// Returns permission required for request to passed admin tab with
+// passed method. Unknown tabs require admin's permission, so new tab
+// which was forgotten here isn't open to everyone.
+func requiredPermission(tab string, method string) string {
+	access, known := tabsAccess[tab]
+	if !known {
+		return users.PermSettingsManage
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return access.View
+	}
+
+	return access.Manage
+}
+
+// Returns true if current user can view passed tab. Requests made with
+// access token are limited by token's scopes too.
+func canViewTab(ec echo.Context, tab string) bool {
+	return users.CurrentUserCan(ec, requiredPermission(tab, http.MethodGet))
+}
+
+// Checks that logged in user's role (and access token's scopes, if
+// request was made with token) allows request to admin interface.
+// Anonymous requests are passed through, handlers send them to login
+// form.
+func accessEnforcer() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ec echo.Context) error {
+			path := ec.Request().URL.Path
+			authorized, _ := ec.Get("AUTHORIZED").(bool)
+			if !authorized || !strings.HasPrefix(path, "/admin/") {
+				return next(ec)
+			}
+
+			tab := strings.SplitN(strings.TrimPrefix(path, "/admin/"), "/", 2)[0]
+			permission := requiredPermission(tab, ec.Request().Method)
+
+			if !users.CurrentUserCan(ec, permission) {
+				log.Warn().Msgf("Access to %s %s denied: permission '%s' is required", ec.Request().Method, path, permission)
+				return forbidden(ec)
+			}
+
+			return next(ec)
+		}
+	}
+}
+
+// Responds with "access denied" page.
+func forbidden(ec echo.Context) error {
+	if strings.HasSuffix(ec.Request().URL.Path, ".json") {
+		return ec.JSON(http.StatusForbidden, map[string]string{"message": "Access denied"})
+	}
+
+	return ec.HTML(http.StatusForbidden, templater.GetTemplate(ec, "admin/forbidden.html", map[string]string{}))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns context of request made with access token which has passed
+// scopes.
+func tokenContext(method string, path string, scopes string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer mag_test")
+	rec := httptest.NewRecorder()
+
+	ec := echo.New().NewContext(req, rec)
+	ec.Set("AUTHORIZED", true)
+	ec.Set("UID", 1)
+	ec.Set("ACCESS_TOKEN", &users.AccessToken{UserID: 1, Scopes: scopes})
+
+	return ec, rec
+}
+
+// Token scopes are checked before user's role, so none of these cases
+// reach database.
+func TestAccessEnforcerTokenScopes(t *testing.T) {
+	// Access denied page shows site name.
+	config.Config = &config.Configuration{}
+
+	cases := []struct {
+		method string
+		path   string
+		scopes string
+	}{
+		{http.MethodPost, "/admin/packages/", users.ScopePackagesRead},
+		{http.MethodPost, "/admin/users/", users.ScopePackagesRead},
+		{http.MethodPost, "/admin/settings/", users.ScopePackagesWrite},
+		{http.MethodGet, "/admin/users/", users.ScopePackagesWrite},
+		{http.MethodGet, "/admin/status.json", users.ScopePackagesRead},
+	}
+
+	for _, c := range cases {
+		ec, rec := tokenContext(c.method, c.path, c.scopes)
+
+		passed := false
+		handler := accessEnforcer()(func(echo.Context) error {
+			passed = true
+			return nil
+		})
+
+		if err := handler(ec); err != nil {
+			t.Fatalf("%s %s with '%s' token: unexpected error: %s", c.method, c.path, c.scopes, err.Error())
+		}
+
+		if passed {
+			t.Errorf("%s %s with '%s' token: request reached handler", c.method, c.path, c.scopes)
+		}
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s %s with '%s' token: got status %d, want %d", c.method, c.path, c.scopes, rec.Code, http.StatusForbidden)
+		}
+	}
+}
+
+func TestCanViewTabTokenScopes(t *testing.T) {
+	ec, _ := tokenContext(http.MethodGet, "/admin/", users.ScopePackagesRead)
+
+	for _, tab := range []string{"index", "webhooks", "users", "settings", "audit"} {
+		if canViewTab(ec, tab) {
+			t.Errorf("Tab '%s' is shown for 'packages:read' token", tab)
+		}
+	}
+}
@@ -26,10 +26,12 @@ import (
 	"net/http"
 
 	// local
+	h "github.com/welltrainedfolks/magister/internal/http"
 	"github.com/welltrainedfolks/magister/internal/templater"
 
 	// other
 	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
 )
 
 // adminGET is a handler for admin interface.
@@ -38,25 +40,94 @@ func adminGET(ec echo.Context) error {
 		return ec.Redirect(http.StatusMovedPermanently, "/login_required/")
 	}
 
-	data := make(map[string]string)
-
 	var tabTpl string
 	tab := ec.Param("tab")
 	if tab == "index" {
-		tabTpl = templater.GetRawTemplate(ec, "admin/index.html", nil)
+		tabTpl = dashboardTab(ec)
 	} else if tab == "packages" {
-		tabTpl = templater.GetRawTemplate(ec, "admin/packages.html", nil)
+		tabTpl = packagesTab(ec)
+	} else if tab == "routing" {
+		tabTpl = routingTab(ec, nil, nil)
+	} else if tab == "resolve" {
+		tabTpl = resolveTab(ec)
+	} else if tab == "hooks" {
+		tabTpl = hooksTab(ec, nil, nil)
+	} else if tab == "webhooks" {
+		tabTpl = webhooksTab(ec, nil, nil)
+	} else if tab == "vulns" {
+		tabTpl = vulnerabilitiesTab(ec)
+	} else if tab == "snapshots" {
+		tabTpl = snapshotsTab(ec, nil, nil)
+	} else if tab == "graph" {
+		tabTpl = graphTab(ec)
+	} else if tab == "users" {
+		tabTpl = usersTab(ec)
+	} else if tab == "settings" {
+		tabTpl = settingsTab(ec, nil, nil)
+	} else if tab == "audit" {
+		tabTpl = auditTab(ec)
+	}
+
+	return ec.HTML(http.StatusOK, adminPage(ec, tab, tabTpl))
+}
+
+// adminPOST is a handler for admin interface forms.
+func adminPOST(ec echo.Context) error {
+	if !ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/login_required/")
+	}
+
+	tab := ec.Param("tab")
+	log.Debug().Msgf("Admin POST on tab %s", tab)
+
+	if tab == "packages" {
+		return packagesPOST(ec)
+	} else if tab == "routing" {
+		return routingPOST(ec)
+	} else if tab == "hooks" {
+		return hooksPOST(ec)
+	} else if tab == "webhooks" {
+		return webhooksPOST(ec)
+	} else if tab == "snapshots" {
+		return snapshotsPOST(ec)
+	} else if tab == "users" {
+		return usersPOST(ec)
+	} else if tab == "settings" {
+		return settingsPOST(ec)
 	}
 
+	return h.NotFoundGET(ec)
+}
+
+// Returns admin page with passed tab's data.
+func adminPage(ec echo.Context, tab string, tabTpl string) string {
+	data := make(map[string]string)
 	data["tab.data"] = tabTpl
 
+	// Tabs user can't use are hidden.
+	for t := range tabsAccess {
+		data["tab."+t+".hidden"] = ""
+		if !canViewTab(ec, t) {
+			data["tab."+t+".hidden"] = "is-hidden"
+		}
+	}
+	data["menu.users.hidden"] = data["tab.users.hidden"]
+
 	// Tabs.
 	data["tab.index.active"] = ""
 	data["tab.packages.active"] = ""
+	data["tab.routing.active"] = ""
+	data["tab.resolve.active"] = ""
+	data["tab.hooks.active"] = ""
+	data["tab.webhooks.active"] = ""
+	data["tab.vulns.active"] = ""
+	data["tab.snapshots.active"] = ""
+	data["tab.graph.active"] = ""
+	data["tab.users.active"] = ""
+	data["tab.settings.active"] = ""
+	data["tab.audit.active"] = ""
 	// ...and activate required.
 	data["tab."+tab+".active"] = "is-active"
 
-	htmlData := templater.GetTemplate(ec, "admin/skeleton.html", data)
-
-	return ec.HTML(http.StatusOK, htmlData)
+	return templater.GetTemplate(ec, "admin/skeleton.html", data)
 }
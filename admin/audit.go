@@ -0,0 +1,317 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"encoding/csv"
+	"encoding/json"
+	"html"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/proxy"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many audit log events are shown on one page.
+const auditEventsPerPage = 50
+
+// Actions groups which might be used as filter.
+var auditActionGroups = []string{"package", "url", "user", "auth", "settings"}
+
+// Event as it's exported to JSON. Changes are embedded as objects.
+type auditExportEvent struct {
+	*audit.Event
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// Returns audit log filter from query parameters and query parameters
+// which should be passed to keep it.
+func auditFilter(ec echo.Context) (*audit.Filter, url.Values) {
+	params := url.Values{}
+	f := &audit.Filter{
+		Action: strings.TrimSpace(ec.QueryParam("action")),
+		Actor:  strings.TrimSpace(ec.QueryParam("actor")),
+		Target: strings.TrimSpace(ec.QueryParam("target")),
+	}
+
+	params.Set("action", f.Action)
+	params.Set("actor", f.Actor)
+	params.Set("target", f.Target)
+
+	// Dates are days, "to" date is included.
+	if from, err := time.Parse("2006-01-02", ec.QueryParam("from")); err == nil {
+		f.From = from
+		params.Set("from", ec.QueryParam("from"))
+	}
+	if to, err := time.Parse("2006-01-02", ec.QueryParam("to")); err == nil {
+		f.To = to.AddDate(0, 0, 1)
+		params.Set("to", ec.QueryParam("to"))
+	}
+
+	return f, params
+}
+
+// Returns audit log tab's HTML.
+func auditTab(ec echo.Context) string {
+	f, params := auditFilter(ec)
+
+	page, _ := strconv.Atoi(ec.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	total := audit.CountEvents(f)
+	pages := (total + auditEventsPerPage - 1) / auditEventsPerPage
+	if pages == 0 {
+		pages = 1
+	}
+	if page > pages {
+		page = pages
+	}
+
+	var eventsHTML string
+	for _, event := range audit.GetEvents(f, (page-1)*auditEventsPerPage, auditEventsPerPage) {
+		actor := event.ActorLogin
+		if actor == "" {
+			actor = "anonymous"
+		}
+
+		eventsHTML += templater.GetRawTemplate(ec, "admin/audit_event.html", map[string]string{
+			"event.created_at": event.CreatedAt.Format("2006-01-02 15:04:05"),
+			"event.actor":      html.EscapeString(actor),
+			"event.ip":         html.EscapeString(event.IP),
+			"event.action":     event.Action,
+			"event.target":     html.EscapeString(event.Target),
+			"event.changes":    describeAuditChanges(event),
+		})
+	}
+
+	// Actions groups first, then actions.
+	var actions string
+	for _, group := range append([]string{""}, auditActionGroups...) {
+		name := "all " + group + " actions"
+		if group == "" {
+			name = "all actions"
+		}
+		actions += auditOption(group, name, f.Action)
+	}
+	for _, action := range audit.Actions {
+		actions += auditOption(action, action, f.Action)
+	}
+
+	// Pagination adds page to parameters.
+	exportQuery := params.Encode()
+
+	return templater.GetRawTemplate(ec, "admin/audit.html", map[string]string{
+		"filter.actions": actions,
+		"filter.actor":   html.EscapeString(f.Actor),
+		"filter.target":  html.EscapeString(f.Target),
+		"filter.from":    html.EscapeString(params.Get("from")),
+		"filter.to":      html.EscapeString(params.Get("to")),
+		"total":          strconv.Itoa(total),
+		"retention":      strconv.Itoa(settings.Int("audit.retention_days")),
+		"events":         eventsHTML,
+		"pagination":     pagination(ec, "/admin/audit/", params, page, pages),
+		"export.query":   html.EscapeString(exportQuery),
+	})
+}
+
+// Returns <option> tag.
+func auditOption(value string, name string, selected string) string {
+	var sel string
+	if value == selected {
+		sel = " selected"
+	}
+
+	return "<option value=\"" + value + "\"" + sel + ">" + name + "</option>"
+}
+
+// Returns event's changes as "field: before -> after" lines.
+func describeAuditChanges(event *audit.Event) string {
+	before := make(map[string]interface{})
+	after := make(map[string]interface{})
+	if event.Before != "" {
+		json.Unmarshal([]byte(event.Before), &before)
+	}
+	if event.After != "" {
+		json.Unmarshal([]byte(event.After), &after)
+	}
+
+	keys := make(map[string]bool)
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	var lines []string
+	for _, key := range sortedKeys {
+		line := html.EscapeString(key) + ": "
+		if value, found := before[key]; found {
+			line += html.EscapeString(formatAuditValue(value)) + " &rarr; "
+		}
+		if value, found := after[key]; found {
+			line += html.EscapeString(formatAuditValue(value))
+		} else {
+			line += "<i>removed</i>"
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "<br>")
+}
+
+func formatAuditValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// auditExportGET exports audit log events matching filter as JSON or
+// CSV.
+func auditExportGET(ec echo.Context) error {
+	if !ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/login_required/")
+	}
+
+	f, _ := auditFilter(ec)
+	events := audit.GetEvents(f, 0, -1)
+	filename := "audit-" + time.Now().UTC().Format("20060102-150405")
+
+	switch ec.QueryParam("format") {
+	case "json":
+		exported := make([]*auditExportEvent, 0, len(events))
+		for _, event := range events {
+			exported = append(exported, &auditExportEvent{Event: event, Before: rawJSON(event.Before), After: rawJSON(event.After)})
+		}
+
+		ec.Response().Header().Set("Content-Disposition", "attachment; filename=\""+filename+".json\"")
+		return ec.JSON(http.StatusOK, exported)
+	case "csv":
+		ec.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		ec.Response().Header().Set("Content-Disposition", "attachment; filename=\""+filename+".csv\"")
+		ec.Response().WriteHeader(http.StatusOK)
+
+		w := csv.NewWriter(ec.Response())
+		w.Write([]string{"id", "created_at", "actor_id", "actor_login", "ip", "action", "target_type", "target_id", "target", "before", "after"})
+		for _, event := range events {
+			w.Write([]string{
+				strconv.Itoa(event.ID),
+				event.CreatedAt.Format(time.RFC3339),
+				strconv.Itoa(event.ActorID),
+				event.ActorLogin,
+				event.IP,
+				event.Action,
+				event.TargetType,
+				strconv.Itoa(event.TargetID),
+				event.Target,
+				event.Before,
+				event.After,
+			})
+		}
+		w.Flush()
+
+		if err := w.Error(); err != nil {
+			log.Error().Msgf("Failed to export audit log as CSV: %s", err.Error())
+		}
+
+		return nil
+	}
+
+	return ec.String(http.StatusBadRequest, "Unknown format, 'json' or 'csv' expected.")
+}
+
+// Returns JSON which might be embedded, "null" for empty one.
+func rawJSON(data string) json.RawMessage {
+	if data == "" {
+		return json.RawMessage("null")
+	}
+
+	return json.RawMessage(data)
+}
+
+// Returns audit log event for action on package.
+func packageEvent(action string, pkg *packages.Package) *audit.Event {
+	return &audit.Event{Action: action, TargetType: audit.TargetPackage, TargetID: pkg.ID, Target: pkg.OriginalPackageURL}
+}
+
+// Returns audit log event for action on package's sources URL.
+func urlEvent(action string, pkg *packages.Package, u *packages.URL) *audit.Event {
+	return &audit.Event{Action: action, TargetType: audit.TargetURL, TargetID: u.ID, Target: pkg.OriginalPackageURL + " " + u.URL}
+}
+
+// Returns audit log event for action on module proxy's snapshot.
+func snapshotEvent(action string, s *proxy.Snapshot) *audit.Event {
+	return &audit.Event{Action: action, TargetType: audit.TargetSnapshot, TargetID: s.ID, Target: s.Module + "@" + s.Version}
+}
+
+// Returns audit log event for action on user.
+func userEvent(action string, u *users.User) *audit.Event {
+	return &audit.Event{Action: action, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}
+}
+
+// Returns audit log event for action on setting.
+func settingEvent(action string, s *settings.Setting) *audit.Event {
+	return &audit.Event{Action: action, TargetType: audit.TargetSetting, Target: s.Key}
+}
+
+// Returns setting's value and source for audit log. Secret values are
+// never written.
+func auditSetting(s *settings.Setting) map[string]interface{} {
+	value := settings.Get(s.Key)
+	data := map[string]interface{}{
+		"value":  value.Value,
+		"source": value.Source,
+	}
+
+	if s.Secret {
+		data["value"] = value.Value != ""
+	}
+
+	return data
+}
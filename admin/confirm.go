@@ -0,0 +1,52 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns confirmation form for destructive action. Form re-submits
+// passed fields to passed URL with "confirm" field set.
+func confirmTab(ec echo.Context, message string, action string, cancelURL string, fields map[string]string) string {
+	var fieldsHTML string
+	for name, value := range fields {
+		fieldsHTML += templater.GetRawTemplate(ec, "admin/confirm_field.html", map[string]string{
+			"field.name":  html.EscapeString(name),
+			"field.value": html.EscapeString(value),
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/confirm.html", map[string]string{
+		"confirm.message": message,
+		"confirm.action":  action,
+		"confirm.cancel":  cancelURL,
+		"confirm.fields":  fieldsHTML,
+	})
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/common"
+	"github.com/welltrainedfolks/magister/hooks"
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/mailsender"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/proxy"
+	"github.com/welltrainedfolks/magister/users"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// How many entries are shown in dashboard's lists.
+const dashboardListSize = 10
+
+var startedAt = time.Now().UTC()
+
+// Status is a system status shown on dashboard.
+type Status struct {
+	Version      string              `json:"version"`
+	Build        int                 `json:"build"`
+	BuildDate    string              `json:"build_date"`
+	Revision     string              `json:"revision"`
+	Branch       string              `json:"branch"`
+	StartedAt    time.Time           `json:"started_at"`
+	Uptime       string              `json:"uptime"`
+	Database     DatabaseStatus      `json:"database"`
+	Packages     map[string]int      `json:"packages"`
+	Users        int                 `json:"users"`
+	ActiveUsers  int                 `json:"active_users"`
+	Sessions     int                 `json:"sessions"`
+	Upstreams    UpstreamsStatus     `json:"upstreams"`
+	Mail         mailsender.Stats    `json:"mail"`
+	NotFound     []packages.NotFound `json:"not_found"`
+	AdminActions []*audit.Event      `json:"admin_actions"`
+}
+
+type DatabaseStatus struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	MigrationVersion int64  `json:"migration_version"`
+}
+
+// UpstreamsStatus summarizes what is known about packages sources:
+// results of last scheduled probes, webhooks activity for last 24 hours
+// and module proxy's comparisons of snapshots with upstream.
+type UpstreamsStatus struct {
+	URLsEnabled  int `json:"urls_enabled"`
+	URLsDisabled int `json:"urls_disabled"`
+	// Enabled sources URLs by last probe's result.
+	Reachable      int            `json:"reachable"`
+	Failing        int            `json:"failing"`
+	NotProbed      int            `json:"not_probed"`
+	LastProbeAt    *time.Time     `json:"last_probe_at"`
+	LastSuccessAt  *time.Time     `json:"last_success_at"`
+	FailingURLs    []*FailingURL  `json:"failing_urls"`
+	InboundHooks   map[string]int `json:"inbound_hooks"`
+	OutgoingEvents map[string]int `json:"outgoing_webhooks"`
+	// Module proxy's snapshots which vanished or changed upstream.
+	SnapshotDiscrepancies int `json:"snapshot_discrepancies"`
+}
+
+// FailingURL is sources URL which was unreachable on last probe.
+type FailingURL struct {
+	Package       string     `json:"package"`
+	URL           string     `json:"url"`
+	Mirror        string     `json:"mirror"`
+	ProbedAt      *time.Time `json:"probed_at"`
+	LastSuccessAt *time.Time `json:"last_success_at"`
+	LastError     string     `json:"last_error"`
+}
+
+// Collects system status. Recent admin actions are collected only if
+// withAuditLog is true, audit log isn't available to every role.
+func getStatus(withAuditLog bool) *Status {
+	status := &Status{
+		Version:   common.VERSION,
+		Build:     common.BUILD,
+		BuildDate: common.BUILDDATE,
+		Revision:  common.REVISION,
+		Branch:    common.BRANCH,
+		StartedAt: startedAt,
+		Uptime:    formatDuration(time.Since(startedAt)),
+		Mail:      mailsender.GetStats(),
+		NotFound:  packages.RecentNotFound(dashboardListSize),
+	}
+
+	version, err := database.Check()
+	if err != nil {
+		status.Database.Error = err.Error()
+		// Nothing else can be obtained without database.
+		return status
+	}
+
+	status.Database.OK = true
+	status.Database.MigrationVersion = version
+
+	status.Packages = packages.CountPackagesByState()
+	status.Users = users.CountUsers("")
+	status.ActiveUsers = users.CountActiveUsers()
+	status.Sessions = sessionkeys.CountAllActiveSessions()
+	if withAuditLog {
+		status.AdminActions = audit.GetEvents(&audit.Filter{}, 0, dashboardListSize)
+	}
+
+	since := time.Now().UTC().Add(-24 * time.Hour)
+	status.Upstreams.URLsEnabled, status.Upstreams.URLsDisabled = packages.CountURLs()
+	probes := packages.GetProbesStats()
+	status.Upstreams.Reachable = probes.Reachable
+	status.Upstreams.Failing = probes.Failing
+	status.Upstreams.NotProbed = probes.NotProbed
+	status.Upstreams.LastProbeAt = probes.LastProbeAt
+	status.Upstreams.LastSuccessAt = probes.LastSuccessAt
+	status.Upstreams.FailingURLs = getFailingURLs()
+	status.Upstreams.InboundHooks = hooks.CountDeliveriesSince(since)
+	status.Upstreams.OutgoingEvents = webhooks.CountDeliveriesSince(since)
+	status.Upstreams.SnapshotDiscrepancies = proxy.CountDiscrepancies()
+
+	return status
+}
+
+// Returns sources URLs which were unreachable on last probe, along with
+// their packages.
+func getFailingURLs() []*FailingURL {
+	failing := []*FailingURL{}
+	for _, u := range packages.GetFailingURLs(dashboardListSize) {
+		f := &FailingURL{URL: u.URL, Mirror: u.Mirror, ProbedAt: u.ProbedAt, LastSuccessAt: u.LastSuccessAt, LastError: u.LastError}
+		if pkg := packages.GetPackageByID(u.PackageID); pkg != nil {
+			f.Package = pkg.OriginalPackageURL
+		}
+		failing = append(failing, f)
+	}
+
+	return failing
+}
+
+// Formats optional time for dashboard, "never" if it's not set.
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// Formats duration as "1d 2h 3m".
+func formatDuration(d time.Duration) string {
+	minutes := int(d.Minutes())
+	days := minutes / (24 * 60)
+	hours := minutes / 60 % 24
+
+	return strconv.Itoa(days) + "d " + strconv.Itoa(hours) + "h " + strconv.Itoa(minutes%60) + "m"
+}
+
+// statusGET returns system status as JSON, dashboard refreshes itself
+// with it.
+func statusGET(ec echo.Context) error {
+	if !ec.Get("AUTHORIZED").(bool) {
+		return ec.JSON(http.StatusUnauthorized, map[string]string{"message": "Authorization required"})
+	}
+
+	return ec.JSON(http.StatusOK, getStatus(users.CurrentUserCan(ec, users.PermAuditView)))
+}
+
+// Returns dashboard. Initial values are rendered here, so dashboard is
+// usable without JavaScript.
+func dashboardTab(ec echo.Context) string {
+	withAuditLog := users.CurrentUserCan(ec, users.PermAuditView)
+	status := getStatus(withAuditLog)
+
+	data := map[string]string{
+		"status.version":           html.EscapeString(status.Version),
+		"status.build":             strconv.Itoa(status.Build),
+		"status.build_date":        html.EscapeString(status.BuildDate),
+		"status.revision":          html.EscapeString(status.Revision),
+		"status.branch":            html.EscapeString(status.Branch),
+		"status.uptime":            status.Uptime,
+		"status.database":          "OK",
+		"status.migration_version": strconv.FormatInt(status.Database.MigrationVersion, 10),
+		"status.packages":          "",
+		"status.users":             strconv.Itoa(status.Users),
+		"status.active_users":      strconv.Itoa(status.ActiveUsers),
+		"status.sessions":          strconv.Itoa(status.Sessions),
+		"status.urls_enabled":      strconv.Itoa(status.Upstreams.URLsEnabled),
+		"status.urls_disabled":     strconv.Itoa(status.Upstreams.URLsDisabled),
+		"status.reachable":         strconv.Itoa(status.Upstreams.Reachable),
+		"status.failing":           strconv.Itoa(status.Upstreams.Failing),
+		"status.not_probed":        strconv.Itoa(status.Upstreams.NotProbed),
+		"status.last_probe_at":     formatOptionalTime(status.Upstreams.LastProbeAt),
+		"status.last_success_at":   formatOptionalTime(status.Upstreams.LastSuccessAt),
+		"status.failing_urls":      "",
+		"status.inbound_hooks":     describeCounts(status.Upstreams.InboundHooks),
+		"status.outgoing_webhooks": describeCounts(status.Upstreams.OutgoingEvents),
+		"status.snapshots":         strconv.Itoa(status.Upstreams.SnapshotDiscrepancies),
+		"status.mail_sent":         strconv.Itoa(status.Mail.Sent),
+		"status.mail_failed":       strconv.Itoa(status.Mail.Failed),
+		"status.mail_last_error":   html.EscapeString(status.Mail.LastError),
+		"status.not_found":         "",
+		"status.admin_actions":     "",
+		"admin_actions.hidden":     "",
+	}
+
+	if !withAuditLog {
+		data["admin_actions.hidden"] = "is-hidden"
+	}
+
+	if !status.Database.OK {
+		data["status.database"] = "unavailable: " + html.EscapeString(status.Database.Error)
+	}
+
+	var total int
+	for _, state := range packages.States {
+		total += status.Packages[state]
+	}
+	data["status.packages"] = strconv.Itoa(total) + " (" + describeCounts(status.Packages) + ")"
+
+	for _, f := range status.Upstreams.FailingURLs {
+		data["status.failing_urls"] += "<tr><td>" + html.EscapeString(f.Package) + "</td><td>" + html.EscapeString(f.URL) + "</td><td>" + html.EscapeString(f.Mirror) + "</td><td>" + formatOptionalTime(f.LastSuccessAt) + "</td><td>" + formatOptionalTime(f.ProbedAt) + "</td><td>" + html.EscapeString(f.LastError) + "</td></tr>"
+	}
+
+	for _, nf := range status.NotFound {
+		data["status.not_found"] += "<tr><td>" + html.EscapeString(nf.ImportPath) + "</td><td>" + strconv.Itoa(nf.Count) + "</td><td>" + nf.LastSeenAt.Format("2006-01-02 15:04:05") + "</td></tr>"
+	}
+
+	for _, event := range status.AdminActions {
+		data["status.admin_actions"] += "<tr><td>" + event.CreatedAt.Format("2006-01-02 15:04:05") + "</td><td>" + html.EscapeString(event.ActorLogin) + "</td><td>" + event.Action + "</td><td>" + html.EscapeString(event.Target) + "</td></tr>"
+	}
+
+	return templater.GetRawTemplate(ec, "admin/index.html", data)
+}
+
+// Returns "key: count" list for counts map, or "none".
+func describeCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var description string
+	for _, key := range keys {
+		if description != "" {
+			description += ", "
+		}
+		description += html.EscapeString(key) + ": " + strconv.Itoa(counts[key])
+	}
+
+	if description == "" {
+		return "none"
+	}
+
+	return description
+}
@@ -32,6 +32,16 @@ import (
 func Initialize() {
 	log.Info().Msg("Initializing 'admin' module...")
 
+	// Role-based access control for everything below.
+	http.E.Use(accessEnforcer())
+
 	// Admin index.
 	http.E.GET("/admin/:tab/", adminGET)
+	http.E.POST("/admin/:tab/", adminPOST)
+
+	// Audit log export.
+	http.E.GET("/admin/audit/export/", auditExportGET)
+
+	// System status for dashboard.
+	http.E.GET("/admin/status.json", statusGET)
 }
@@ -0,0 +1,321 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/proxy"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Dependency graph's geometry, in pixels.
+const (
+	graphNodeWidth  = 320
+	graphNodeHeight = 40
+	graphColumnGap  = 100
+	graphRowGap     = 16
+	// Longer module paths are shortened, full path is shown on hover.
+	graphLabelLength = 44
+)
+
+// Module drawn on dependency graph.
+type graphNode struct {
+	Module  string
+	Version string
+	Column  int
+	Row     int
+	Focused bool
+}
+
+// Returns dependencies tab's HTML. Without module whole graph of served
+// modules is shown, with module only modules it depends on and which
+// depend on it are shown, along with impact query.
+func graphTab(ec echo.Context) string {
+	module := strings.TrimSpace(ec.QueryParam("module"))
+	version := strings.TrimSpace(ec.QueryParam("version"))
+
+	status := "enabled"
+	if !proxy.Enabled() {
+		status = "disabled, graph isn't updated"
+	}
+
+	data := map[string]string{
+		"proxy.status":  status,
+		"graph.module":  html.EscapeString(module),
+		"graph.modules": "",
+		"graph.svg":     "",
+		"graph.impact":  "",
+	}
+
+	for _, m := range proxy.ServedModules() {
+		data["graph.modules"] += "<option value=\"" + html.EscapeString(m) + "\">"
+	}
+
+	if module == "" {
+		data["graph.versions"] = auditOption("", "Latest", "")
+		data["graph.svg"] = overviewGraph()
+		return templater.GetRawTemplate(ec, "admin/graph.html", data)
+	}
+
+	snapshots := proxy.GetModuleSnapshots(module)
+	sort.Slice(snapshots, func(i, j int) bool {
+		return helpers.CompareVersions(snapshots[i].Version, snapshots[j].Version) > 0
+	})
+
+	data["graph.versions"] = auditOption("", "Latest", version)
+	for _, s := range snapshots {
+		data["graph.versions"] += auditOption(html.EscapeString(s.Version), html.EscapeString(s.Version), version)
+	}
+
+	data["graph.svg"] = focusGraph(module, version)
+
+	_, asked := ec.QueryParams()["impact"]
+	data["graph.impact"] = impactQuery(ec, module, strings.TrimSpace(ec.QueryParam("impact")), asked)
+
+	return templater.GetRawTemplate(ec, "admin/graph.html", data)
+}
+
+// Returns graph of dependencies between latest versions of every served
+// module. Dependents are placed left of their dependencies.
+func overviewGraph() string {
+	modules := proxy.ServedModules()
+	deps := proxy.Graph()
+
+	requires := make(map[string][]string)
+	for _, dep := range deps {
+		requires[dep.Module] = append(requires[dep.Module], dep.Requires)
+	}
+
+	// Depth is the longest chain of dependencies below module. Modules
+	// might require each other, such cycles are cut.
+	depths := make(map[string]int)
+	visiting := make(map[string]bool)
+	var depth func(module string) int
+	depth = func(module string) int {
+		if d, known := depths[module]; known {
+			return d
+		}
+		if visiting[module] {
+			return 0
+		}
+
+		visiting[module] = true
+		var d int
+		for _, required := range requires[module] {
+			if rd := depth(required) + 1; rd > d {
+				d = rd
+			}
+		}
+		visiting[module] = false
+
+		depths[module] = d
+		return d
+	}
+
+	var maxDepth int
+	for _, module := range modules {
+		if d := depth(module); d > maxDepth {
+			maxDepth = d
+		}
+	}
+
+	nodes := make(map[string]*graphNode)
+	rows := make(map[int]int)
+	for _, module := range modules {
+		column := maxDepth - depths[module]
+		nodes[module] = &graphNode{Module: module, Column: column, Row: rows[column]}
+		if s := proxy.LatestSnapshot(module); s != nil {
+			nodes[module].Version = s.Version
+		}
+		rows[column]++
+	}
+
+	return renderGraph(nodes, deps)
+}
+
+// Returns graph of module's version: modules which latest versions depend
+// on it, module itself and modules it depends on.
+func focusGraph(module string, version string) string {
+	focused := &graphNode{Module: module, Version: version, Column: 1, Focused: true}
+	if version == "" {
+		if s := proxy.LatestSnapshot(module); s != nil {
+			focused.Version = s.Version
+		}
+	}
+
+	nodes := map[string]*graphNode{module: focused}
+
+	usedBy := proxy.UsedBy(module)
+	for i, dep := range usedBy {
+		nodes[dep.Module] = &graphNode{Module: dep.Module, Version: dep.Version, Column: 0, Row: i}
+	}
+
+	dependsOn := proxy.DependsOn(module, version)
+	for i, dep := range dependsOn {
+		if _, drawn := nodes[dep.Requires]; drawn {
+			continue
+		}
+		nodes[dep.Requires] = &graphNode{Module: dep.Requires, Version: dep.RequiresVersion, Column: 2, Row: i}
+	}
+
+	// Module is placed in the middle of tallest column.
+	rows := len(usedBy)
+	if len(dependsOn) > rows {
+		rows = len(dependsOn)
+	}
+	if rows > 1 {
+		focused.Row = (rows - 1) / 2
+	}
+
+	return renderGraph(nodes, append(usedBy, dependsOn...))
+}
+
+// Renders graph as SVG. Clicking module shows its dependencies.
+func renderGraph(nodes map[string]*graphNode, deps []*proxy.Dependency) string {
+	if len(nodes) == 0 {
+		return "<p>No modules were snapshotted yet.</p>"
+	}
+
+	var width, height int
+	for _, node := range nodes {
+		x, y := graphPosition(node)
+		if x+graphNodeWidth > width {
+			width = x + graphNodeWidth
+		}
+		if y+graphNodeHeight > height {
+			height = y + graphNodeHeight
+		}
+	}
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" class="dependency-graph" width="` + strconv.Itoa(width+2) + `" height="` + strconv.Itoa(height+2) + `">`
+	svg += `<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z" fill="#7a7a7a"/></marker></defs>`
+
+	for _, dep := range deps {
+		from, to := nodes[dep.Module], nodes[dep.Requires]
+		if from == nil || to == nil {
+			continue
+		}
+
+		x1, y1 := graphPosition(from)
+		x2, y2 := graphPosition(to)
+		x1 += graphNodeWidth
+		y1 += graphNodeHeight / 2
+		y2 += graphNodeHeight / 2
+
+		dash := ""
+		if dep.Indirect {
+			dash = ` stroke-dasharray="4 3"`
+		}
+
+		title := html.EscapeString(dep.Module + " " + dep.Version + " requires " + dep.Requires + " " + dep.RequiresVersion)
+		svg += `<line x1="` + strconv.Itoa(x1) + `" y1="` + strconv.Itoa(y1) + `" x2="` + strconv.Itoa(x2) + `" y2="` + strconv.Itoa(y2) + `" stroke="#7a7a7a"` + dash + ` marker-end="url(#arrow)"><title>` + title + `</title></line>`
+	}
+
+	// Nodes are drawn in stable order.
+	modules := make([]string, 0, len(nodes))
+	for module := range nodes {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		node := nodes[module]
+		x, y := graphPosition(node)
+
+		fill := "#f5f5f5"
+		if node.Focused {
+			fill = "#d0e7f7"
+		}
+
+		label := node.Module
+		if len(label) > graphLabelLength {
+			label = "…" + label[len(label)-graphLabelLength+1:]
+		}
+
+		svg += `<a href="/admin/graph/?module=` + html.EscapeString(url.QueryEscape(node.Module)) + `">`
+		svg += `<title>` + html.EscapeString(node.Module+" "+node.Version) + `</title>`
+		svg += `<rect x="` + strconv.Itoa(x) + `" y="` + strconv.Itoa(y) + `" width="` + strconv.Itoa(graphNodeWidth) + `" height="` + strconv.Itoa(graphNodeHeight) + `" rx="4" fill="` + fill + `" stroke="#3273dc"/>`
+		svg += `<text x="` + strconv.Itoa(x+8) + `" y="` + strconv.Itoa(y+16) + `" font-size="12" fill="#363636">` + html.EscapeString(label) + `</text>`
+		svg += `<text x="` + strconv.Itoa(x+8) + `" y="` + strconv.Itoa(y+32) + `" font-size="11" fill="#7a7a7a">` + html.EscapeString(node.Version) + `</text>`
+		svg += `</a>`
+	}
+
+	return svg + `</svg>`
+}
+
+// Returns position of node's top left corner.
+func graphPosition(node *graphNode) (int, int) {
+	return node.Column*(graphNodeWidth+graphColumnGap) + 1, node.Row*(graphNodeHeight+graphRowGap) + 1
+}
+
+// Returns impact query form and, if query was asked, snapshotted
+// versions which require module's versions matching query.
+func impactQuery(ec echo.Context, module string, query string, asked bool) string {
+	data := map[string]string{
+		"impact.module": html.EscapeString(module),
+		"impact.query":  html.EscapeString(query),
+		"impact.result": "",
+	}
+
+	if !asked {
+		return templater.GetRawTemplate(ec, "admin/graph_impact.html", data)
+	}
+
+	var rows string
+	for _, dep := range proxy.Impact(module, query) {
+		version := html.EscapeString(dep.Version)
+		if dep.Latest {
+			version += ` <span class="tag is-info">latest</span>`
+		}
+
+		var indirect string
+		if dep.Indirect {
+			indirect = "indirect"
+		}
+
+		rows += templater.GetRawTemplate(ec, "admin/graph_impact_item.html", map[string]string{
+			"dependency.module":           html.EscapeString(dep.Module),
+			"dependency.module_query":     html.EscapeString(url.QueryEscape(dep.Module)),
+			"dependency.version":          version,
+			"dependency.requires_version": html.EscapeString(dep.RequiresVersion),
+			"dependency.indirect":         indirect,
+		})
+	}
+
+	data["impact.result"] = "<p>No snapshotted version of served modules requires matching version.</p>"
+	if rows != "" {
+		data["impact.result"] = templater.GetRawTemplate(ec, "admin/graph_impact_table.html", map[string]string{"dependencies": rows})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/graph_impact.html", data)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/hooks"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many latest deliveries are shown.
+const hooksDeliveriesShown = 100
+
+type HookDeliveryRequest struct {
+	Action string `form:"action"`
+	ID     int    `form:"id"`
+}
+
+// Returns hooks tab's HTML.
+func hooksTab(ec echo.Context, errors []string, successes []string) string {
+	var deliveriesHTML string
+	for _, d := range hooks.GetDeliveries(hooksDeliveriesShown) {
+		var replay string
+		if d.IsReplayable() {
+			replay = templater.GetRawTemplate(ec, "admin/hooks_replay.html", map[string]string{"delivery.id": strconv.Itoa(d.ID)})
+		}
+
+		var replayOf string
+		if d.ReplayOf != 0 {
+			replayOf = " (replay of #" + strconv.Itoa(d.ReplayOf) + ")"
+		}
+
+		deliveriesHTML += templater.GetRawTemplate(ec, "admin/hooks_delivery.html", map[string]string{
+			"delivery.id":         strconv.Itoa(d.ID),
+			"delivery.replay_of":  replayOf,
+			"delivery.created_at": d.CreatedAt.Format("2006-01-02 15:04:05"),
+			"delivery.provider":   d.Provider,
+			"delivery.event":      html.EscapeString(d.Event),
+			"delivery.repository": html.EscapeString(d.RepositoryURL),
+			"delivery.ref":        html.EscapeString(d.Ref),
+			"delivery.status":     d.Status,
+			"delivery.message":    html.EscapeString(d.Message),
+			"delivery.packages":   html.EscapeString(strings.Replace(d.Packages, ",", ", ", -1)),
+			"delivery.replay":     replay,
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/hooks.html", map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"deliveries": deliveriesHTML,
+	})
+}
+
+// Replays hooks deliveries.
+func hooksPOST(ec echo.Context) error {
+	req := &HookDeliveryRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	if req.Action == "replay" {
+		d, err := hooks.Replay(req.ID)
+		if err != nil {
+			errors = append(errors, "Failed to replay delivery: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Delivery replayed as #"+strconv.Itoa(d.ID)+": "+d.Status+", "+html.EscapeString(d.Message)+".")
+		}
+	} else {
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	return ec.HTML(status, adminPage(ec, "hooks", hooksTab(ec, errors, successes)))
+}
@@ -0,0 +1,381 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many packages are shown on one page.
+const packagesPerPage = 20
+
+type PackageRequest struct {
+	Action     string `form:"action"`
+	ID         int    `form:"id"`
+	Name       string `form:"name"`
+	ImportPath string `form:"import_path"`
+	State      string `form:"state"`
+	Reason     string `form:"reason"`
+	URLID      int    `form:"url_id"`
+	URL        string `form:"url"`
+	VCS        string `form:"vcs"`
+	Mirror     string `form:"mirror"`
+	Enabled    string `form:"enabled"`
+	Confirm    bool   `form:"confirm"`
+}
+
+// Returns packages tab's HTML: package's page if "id" query parameter
+// is passed, packages list otherwise.
+func packagesTab(ec echo.Context) string {
+	if id, _ := strconv.Atoi(ec.QueryParam("id")); id != 0 {
+		pkg := packages.GetPackageByID(id)
+		if pkg == nil {
+			return packagesListTab(ec, []string{"Package wasn't found."}, nil)
+		}
+
+		return packageTab(ec, pkg, nil, nil)
+	}
+
+	return packagesListTab(ec, nil, nil)
+}
+
+// Returns searchable paginated packages list.
+func packagesListTab(ec echo.Context, errors []string, successes []string) string {
+	query := strings.TrimSpace(ec.QueryParam("q"))
+	page, _ := strconv.Atoi(ec.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	total := packages.CountPackages(query)
+	pages := (total + packagesPerPage - 1) / packagesPerPage
+	if pages == 0 {
+		pages = 1
+	}
+	if page > pages {
+		page = pages
+	}
+
+	var packagesHTML string
+	for _, pkg := range packages.SearchPackages(query, (page-1)*packagesPerPage, packagesPerPage) {
+		packagesHTML += templater.GetRawTemplate(ec, "admin/packages_item.html", map[string]string{
+			"package.id":          strconv.Itoa(pkg.ID),
+			"package.import_path": html.EscapeString(pkg.OriginalPackageURL),
+			"package.name":        html.EscapeString(pkg.Name),
+			"package.state":       pkg.State,
+			"package.urls":        strconv.Itoa(len(pkg.GetURLs())),
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/packages.html", map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"query":      html.EscapeString(query),
+		"total":      strconv.Itoa(total),
+		"packages":   packagesHTML,
+		"pagination": pagination(ec, "/admin/packages/", url.Values{"q": {query}}, page, pages),
+		"states":     stateOptions(packages.States, packages.StatePublished),
+	})
+}
+
+// Returns pagination links.
+func pagination(ec echo.Context, path string, params url.Values, page int, pages int) string {
+	link := func(p int) string {
+		params.Set("page", strconv.Itoa(p))
+		return html.EscapeString(path + "?" + params.Encode())
+	}
+
+	data := map[string]string{
+		"pagination.page":     strconv.Itoa(page),
+		"pagination.pages":    strconv.Itoa(pages),
+		"pagination.previous": "disabled",
+		"pagination.next":     "disabled",
+	}
+
+	if page > 1 {
+		data["pagination.previous"] = "href=\"" + link(page-1) + "\""
+	}
+	if page < pages {
+		data["pagination.next"] = "href=\"" + link(page+1) + "\""
+	}
+
+	return templater.GetRawTemplate(ec, "admin/pagination.html", data)
+}
+
+// Returns <option> tags for passed states.
+func stateOptions(states []string, selected string) string {
+	var options string
+	for _, state := range states {
+		var sel string
+		if state == selected {
+			sel = " selected"
+		}
+		options += "<option value=\"" + state + "\"" + sel + ">" + state + "</option>"
+	}
+
+	return options
+}
+
+// Returns package's page with it's data, state and sources URLs.
+func packageTab(ec echo.Context, pkg *packages.Package, errors []string, successes []string) string {
+	var transitions []string
+	for _, state := range packages.States {
+		if pkg.CanTransitTo(state) {
+			transitions = append(transitions, state)
+		}
+	}
+
+	var urlsHTML string
+	for _, u := range pkg.GetURLs() {
+		toggle := "Disable"
+		status := "enabled"
+		if !u.Enabled {
+			toggle = "Enable"
+			status = "disabled"
+		}
+
+		urlsHTML += templater.GetRawTemplate(ec, "admin/package_url.html", map[string]string{
+			"package.id": strconv.Itoa(pkg.ID),
+			"url.id":     strconv.Itoa(u.ID),
+			"url.url":    html.EscapeString(u.URL),
+			"url.vcs":    u.VCS,
+			"url.mirror": html.EscapeString(u.Mirror),
+			"url.status": status,
+			"url.toggle": toggle,
+		})
+	}
+
+	var vcsOptions string
+	for _, vcs := range packages.KnownVCSes {
+		var sel string
+		if vcs == "git" {
+			sel = " selected"
+		}
+		vcsOptions += "<option value=\"" + vcs + "\"" + sel + ">" + vcs + "</option>"
+	}
+
+	return templater.GetRawTemplate(ec, "admin/package.html", map[string]string{
+		"errorsDiv":            templater.GetErrorFlash(ec, errors),
+		"successDiv":           templater.GetSuccessFlash(ec, successes),
+		"package.id":           strconv.Itoa(pkg.ID),
+		"package.name":         html.EscapeString(pkg.Name),
+		"package.import_path":  html.EscapeString(pkg.OriginalPackageURL),
+		"package.state":        pkg.State,
+		"package.state_reason": html.EscapeString(pkg.StateReason),
+		"package.transitions":  stateOptions(transitions, ""),
+		"package.urls":         urlsHTML,
+		"vcs_options":          vcsOptions,
+	})
+}
+
+// Handles packages forms.
+func packagesPOST(ec echo.Context) error {
+	req := &PackageRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	// Creation is the only action which doesn't need existing package.
+	if req.Action == "create" {
+		pkg := &packages.Package{
+			Name:               strings.TrimSpace(req.Name),
+			OriginalPackageURL: packages.NormalizeImportPath(req.ImportPath),
+			State:              req.State,
+			OwnerID:            ec.Get("UID").(int),
+			CreatedAt:          time.Now().UTC(),
+			UpdatedAt:          time.Now().UTC(),
+		}
+
+		if !packages.IsValidState(pkg.State) {
+			errors = append(errors, "Unknown state '"+html.EscapeString(pkg.State)+"'.")
+		} else if err := pkg.Validate(); err != nil {
+			errors = append(errors, "Invalid package: "+html.EscapeString(err.Error())+".")
+		} else if err1 := pkg.Create(); err1 != nil {
+			errors = append(errors, "Failed to create package: "+html.EscapeString(err1.Error()))
+		}
+
+		if len(errors) != 0 {
+			return ec.HTML(http.StatusBadRequest, adminPage(ec, "packages", packagesListTab(ec, errors, nil)))
+		}
+
+		pkg.Emit(webhooks.EventPackageCreated)
+		audit.Log(ec, packageEvent(audit.ActionPackageCreate, pkg), nil, pkg.AuditData())
+		successes = append(successes, "Package created, now add it's sources URLs.")
+		return ec.HTML(http.StatusOK, adminPage(ec, "packages", packageTab(ec, pkg, nil, successes)))
+	}
+
+	pkg := packages.GetPackageByID(req.ID)
+	if pkg == nil {
+		return ec.HTML(http.StatusBadRequest, adminPage(ec, "packages", packagesListTab(ec, []string{"Package wasn't found."}, nil)))
+	}
+
+	packageURL := "/admin/packages/?id=" + strconv.Itoa(pkg.ID)
+
+	// URL which action is made on.
+	var u *packages.URL
+	if req.URLID != 0 {
+		u = packages.GetURLByID(req.URLID)
+		if u == nil || u.PackageID != pkg.ID {
+			return ec.HTML(http.StatusBadRequest, adminPage(ec, "packages", packageTab(ec, pkg, []string{"Sources URL wasn't found."}, nil)))
+		}
+	}
+
+	var urlsChanged bool
+
+	// Package's state before action, for audit log.
+	before := pkg.AuditData()
+
+	switch {
+	case req.Action == "save":
+		pkg.Name = strings.TrimSpace(req.Name)
+		pkg.OriginalPackageURL = packages.NormalizeImportPath(req.ImportPath)
+		if err := pkg.Validate(); err != nil {
+			errors = append(errors, "Invalid package: "+html.EscapeString(err.Error())+".")
+		} else if err1 := pkg.Save(); err1 != nil {
+			errors = append(errors, "Failed to save package: "+html.EscapeString(err1.Error()))
+		} else {
+			pkg.Emit(webhooks.EventPackageUpdated)
+			audit.Log(ec, packageEvent(audit.ActionPackageUpdate, pkg), before, pkg.AuditData())
+			successes = append(successes, "Package saved.")
+		}
+	case req.Action == "set_state":
+		if err := pkg.SetState(req.State, ec.Get("UID").(int), strings.TrimSpace(req.Reason)); err != nil {
+			errors = append(errors, "Failed to change state: "+html.EscapeString(err.Error())+".")
+		} else {
+			audit.Log(ec, packageEvent(audit.ActionPackageState, pkg), before, pkg.AuditData())
+			successes = append(successes, "State changed to "+pkg.State+".")
+		}
+	case req.Action == "delete" && !req.Confirm:
+		message := "Delete package <b>" + html.EscapeString(pkg.OriginalPackageURL) + "</b> with all it's sources URLs? <code>go get</code> will not be able to obtain it anymore."
+		return ec.HTML(http.StatusOK, adminPage(ec, "packages", confirmTab(ec, message, "/admin/packages/", packageURL, map[string]string{
+			"action": "delete",
+			"id":     strconv.Itoa(pkg.ID),
+		})))
+	case req.Action == "delete":
+		if err := pkg.DeleteRoutingPolicies(); err != nil {
+			errors = append(errors, "Failed to delete package's routing policies: "+html.EscapeString(err.Error()))
+		} else if err1 := pkg.Delete(); err1 != nil {
+			errors = append(errors, "Failed to delete package: "+html.EscapeString(err1.Error()))
+		} else {
+			audit.Log(ec, packageEvent(audit.ActionPackageDelete, pkg), before, nil)
+			return ec.HTML(http.StatusOK, adminPage(ec, "packages", packagesListTab(ec, nil, []string{"Package " + html.EscapeString(pkg.OriginalPackageURL) + " deleted."})))
+		}
+	case req.Action == "add_url":
+		vcs := req.VCS
+		if vcs == "" {
+			vcs = "git"
+		}
+
+		if err := packages.ValidateURL(req.URL, vcs); err != nil {
+			errors = append(errors, "Invalid sources URL: "+html.EscapeString(err.Error())+".")
+		} else if err1 := pkg.AddURL(strings.TrimSpace(req.URL), vcs, strings.TrimSpace(req.Mirror), req.Enabled != ""); err1 != nil {
+			errors = append(errors, "Failed to add sources URL: "+html.EscapeString(err1.Error()))
+		} else {
+			added := &packages.URL{PackageID: pkg.ID, URL: strings.TrimSpace(req.URL), VCS: vcs, Mirror: strings.TrimSpace(req.Mirror), Enabled: req.Enabled != ""}
+			audit.Log(ec, urlEvent(audit.ActionURLCreate, pkg, added), nil, added.AuditData())
+			urlsChanged = true
+			successes = append(successes, "Sources URL added.")
+		}
+	case u == nil:
+		errors = append(errors, "Unknown action.")
+	case req.Action == "toggle_url":
+		urlBefore := u.AuditData()
+		u.Enabled = !u.Enabled
+		if err := u.Save(); err != nil {
+			errors = append(errors, "Failed to save sources URL: "+html.EscapeString(err.Error()))
+			break
+		}
+
+		audit.Log(ec, urlEvent(audit.ActionURLUpdate, pkg, u), urlBefore, u.AuditData())
+		urlsChanged = true
+		if u.Enabled {
+			successes = append(successes, "Sources URL enabled.")
+		} else {
+			successes = append(successes, "Sources URL disabled.")
+		}
+	case req.Action == "move_url_up" || req.Action == "move_url_down":
+		offset := -1
+		if req.Action == "move_url_down" {
+			offset = 1
+		}
+
+		if err := pkg.MoveURL(u, offset); err != nil {
+			errors = append(errors, "Failed to move sources URL: "+html.EscapeString(err.Error()))
+		} else {
+			audit.Log(ec, urlEvent(audit.ActionURLMove, pkg, u), nil, map[string]int{"offset": offset})
+			urlsChanged = true
+		}
+	case req.Action == "delete_url" && !req.Confirm:
+		message := "Delete sources URL <b>" + html.EscapeString(u.URL) + "</b> of package <b>" + html.EscapeString(pkg.OriginalPackageURL) + "</b>?"
+		return ec.HTML(http.StatusOK, adminPage(ec, "packages", confirmTab(ec, message, "/admin/packages/", packageURL, map[string]string{
+			"action": "delete_url",
+			"id":     strconv.Itoa(pkg.ID),
+			"url_id": strconv.Itoa(u.ID),
+		})))
+	case req.Action == "delete_url":
+		if err := u.Delete(); err != nil {
+			errors = append(errors, "Failed to delete sources URL: "+html.EscapeString(err.Error()))
+		} else {
+			audit.Log(ec, urlEvent(audit.ActionURLDelete, pkg, u), u.AuditData(), nil)
+			urlsChanged = true
+			successes = append(successes, "Sources URL deleted.")
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	if urlsChanged {
+		pkg.Emit(webhooks.EventPackageURLsChanged)
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	// Package is re-read, failed save shouldn't show unsaved data as
+	// saved.
+	if fresh := packages.GetPackageByID(pkg.ID); fresh != nil {
+		pkg = fresh
+	}
+
+	return ec.HTML(status, adminPage(ec, "packages", packageTab(ec, pkg, errors, successes)))
+}
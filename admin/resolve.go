@@ -0,0 +1,172 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns resolve tester tab's HTML.
+func resolveTab(ec echo.Context) string {
+	importPath := strings.Trim(strings.TrimSpace(ec.QueryParam("path")), "/")
+	ip := strings.TrimSpace(ec.QueryParam("ip"))
+	host := strings.TrimSpace(ec.QueryParam("host"))
+	login := strings.TrimSpace(ec.QueryParam("login"))
+
+	var result string
+	if importPath != "" {
+		result = resolveResult(ec, importPath, ip, host, login)
+	}
+
+	return templater.GetRawTemplate(ec, "admin/resolve.html", map[string]string{
+		"resolve.path":   html.EscapeString(importPath),
+		"resolve.ip":     html.EscapeString(ip),
+		"resolve.host":   html.EscapeString(host),
+		"resolve.login":  html.EscapeString(login),
+		"resolve.result": result,
+	})
+}
+
+// Shows everything "go get" for passed import path would go through:
+// package matching, visibility, state, routing and response.
+func resolveResult(ec echo.Context, importPath string, ipString string, host string, login string) string {
+	var errors []string
+
+	ip := net.ParseIP(ipString)
+	if ip == nil {
+		errors = append(errors, "Invalid client IP address.")
+	}
+
+	var uid int
+	identity := "anonymous (as <code>go get</code> is)"
+	if login != "" {
+		u := users.GetUserByLogin(login)
+		if u == nil {
+			errors = append(errors, "User '"+html.EscapeString(login)+"' wasn't found.")
+		} else {
+			uid = u.ID
+			identity = html.EscapeString(u.Login) + " (#" + strconv.Itoa(u.ID) + ")"
+		}
+	}
+
+	if len(errors) != 0 {
+		return templater.GetErrorFlash(ec, errors)
+	}
+
+	// By default request is made to import path's host.
+	if host == "" {
+		host = strings.Split(importPath, "/")[0]
+	}
+
+	res := packages.Resolve(ec, importPath, ip, host, uid)
+
+	data := map[string]string{
+		"result.ip":         ip.String(),
+		"result.host":       html.EscapeString(host),
+		"result.identity":   identity,
+		"result.package":    "none, no package's import path is a prefix of requested one",
+		"result.visibility": "&mdash;",
+		"result.policy":     "&mdash;",
+		"result.skipped":    "&mdash;",
+		"result.url":        "&mdash;",
+		"result.status":     strconv.Itoa(res.Status) + " " + http.StatusText(res.Status),
+		"result.body":       "common &quot;not found&quot; page",
+		"result.probe":      "&mdash;",
+	}
+
+	if res.RetryAfter != 0 {
+		data["result.status"] += ", Retry-After: " + strconv.Itoa(res.RetryAfter)
+	}
+
+	if res.Body != "" {
+		data["result.body"] = "<pre>" + html.EscapeString(res.Body) + "</pre>"
+	}
+
+	pkg := res.Package
+	if pkg == nil {
+		return templater.GetRawTemplate(ec, "admin/resolve_result.html", data)
+	}
+
+	data["result.package"] = "<a href=\"/admin/packages/?id=" + strconv.Itoa(pkg.ID) + "\">" + html.EscapeString(pkg.OriginalPackageURL) + "</a> (" + pkg.State + ")"
+	if pkg.OriginalPackageURL != importPath {
+		data["result.package"] += ", requested path is a subpackage"
+	}
+
+	data["result.visibility"] = "visible"
+	if res.Hidden {
+		data["result.visibility"] = "hidden, package is a draft and visible only to its owner and to users who manage packages"
+	}
+
+	route := res.Route
+	if route == nil {
+		return templater.GetRawTemplate(ec, "admin/resolve_result.html", data)
+	}
+
+	data["result.policy"] = "none, default URL is used"
+	if route.Policy != nil {
+		data["result.policy"] = describeRoutingPolicy(route.Policy)
+	}
+
+	data["result.skipped"] = "none"
+	if len(route.Skipped) != 0 {
+		var skipped []string
+		for _, rp := range route.Skipped {
+			skipped = append(skipped, describeRoutingPolicy(rp))
+		}
+		data["result.skipped"] = strings.Join(skipped, "<br>")
+	}
+
+	data["result.url"] = "package has no enabled sources URLs"
+	if route.URL != nil {
+		data["result.url"] = html.EscapeString(route.URL.VCS + " " + route.URL.URL)
+		if route.URL.Mirror != "" {
+			data["result.url"] += " (mirror <b>" + html.EscapeString(route.URL.Mirror) + "</b>)"
+		}
+
+		probe := route.URL.Probe()
+		state := "<span class=\"tag is-danger\">unreachable</span>"
+		if probe.Reachable {
+			state = "<span class=\"tag is-success\">reachable</span>"
+		}
+
+		data["result.probe"] = state + " " + html.EscapeString(probe.Result) + " in " + probe.Latency.Round(time.Millisecond).String()
+		if probe.Method != "" {
+			data["result.probe"] += "<br><small>" + html.EscapeString(probe.Method) + "</small>"
+		}
+	}
+
+	return templater.GetRawTemplate(ec, "admin/resolve_result.html", data)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+type RoutingPolicyRequest struct {
+	Action     string `form:"action"`
+	ID         int    `form:"id"`
+	Package    string `form:"package"`
+	Priority   int    `form:"priority"`
+	MatchType  string `form:"match_type"`
+	MatchValue string `form:"match_value"`
+	Mirror     string `form:"mirror"`
+}
+
+// Returns routing tab's HTML.
+func routingTab(ec echo.Context, errors []string, successes []string) string {
+	// Policies list.
+	var policiesHTML string
+	for _, rp := range packages.GetAllRoutingPolicies() {
+		scope := "global"
+		if rp.PackageID != 0 {
+			pkg := packages.GetPackageByID(rp.PackageID)
+			if pkg != nil {
+				scope = html.EscapeString(pkg.OriginalPackageURL)
+			} else {
+				scope = "package #" + strconv.Itoa(rp.PackageID)
+			}
+		}
+
+		policiesHTML += templater.GetRawTemplate(ec, "admin/routing_policy.html", map[string]string{
+			"policy.id":          strconv.Itoa(rp.ID),
+			"policy.scope":       scope,
+			"policy.priority":    strconv.Itoa(rp.Priority),
+			"policy.match_type":  rp.MatchType,
+			"policy.match_value": html.EscapeString(rp.MatchValue),
+			"policy.mirror":      html.EscapeString(rp.Mirror),
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/routing.html", map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"policies":   policiesHTML,
+	})
+}
+
+// Returns human-readable policy description.
+func describeRoutingPolicy(rp *packages.RoutingPolicy) string {
+	scope := "global"
+	if rp.PackageID != 0 {
+		scope = "package"
+	}
+
+	return "#" + strconv.Itoa(rp.ID) + " (" + scope + "): " + rp.MatchType + " " + html.EscapeString(rp.MatchValue) + " &rarr; " + html.EscapeString(rp.Mirror)
+}
+
+// Adds or deletes routing policies.
+func routingPOST(ec echo.Context) error {
+	req := &RoutingPolicyRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	switch req.Action {
+	case "add":
+		rp := &packages.RoutingPolicy{
+			Priority:   req.Priority,
+			MatchType:  req.MatchType,
+			MatchValue: strings.TrimSpace(req.MatchValue),
+			Mirror:     strings.TrimSpace(req.Mirror),
+			Enabled:    true,
+		}
+
+		if req.Package != "" {
+			pkg := packages.GetPackageByOriginalURL(strings.TrimSpace(req.Package))
+			if pkg == nil {
+				errors = append(errors, "Package '"+html.EscapeString(req.Package)+"' wasn't found.")
+			} else {
+				rp.PackageID = pkg.ID
+			}
+		}
+
+		if len(errors) == 0 {
+			if err := rp.Create(); err != nil {
+				errors = append(errors, "Failed to add policy: "+html.EscapeString(err.Error()))
+			} else {
+				successes = append(successes, "Policy added.")
+			}
+		}
+	case "delete":
+		rp := packages.GetRoutingPolicyByID(req.ID)
+		if rp == nil {
+			errors = append(errors, "Policy wasn't found.")
+		} else if err := rp.Delete(); err != nil {
+			errors = append(errors, "Failed to delete policy: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Policy deleted.")
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	return ec.HTML(status, adminPage(ec, "routing", routingTab(ec, errors, successes)))
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Human-readable settings values sources and tags colors.
+var settingsSources = map[string][2]string{
+	settings.SourceDefault:     {"built-in default", "is-light"},
+	settings.SourceFile:        {"configuration file", "is-info"},
+	settings.SourceDatabase:    {"web interface", "is-warning"},
+	settings.SourceEnvironment: {"environment", "is-danger"},
+}
+
+// Returns settings tab's HTML.
+func settingsTab(ec echo.Context, errors []string, successes []string) string {
+	var groupsHTML string
+	for _, group := range settings.Groups {
+		var itemsHTML string
+		for _, s := range settings.Definitions() {
+			if s.Group == group {
+				itemsHTML += settingsItem(ec, s)
+			}
+		}
+
+		groupsHTML += templater.GetRawTemplate(ec, "admin/settings_group.html", map[string]string{
+			"group.name":     group,
+			"group.settings": itemsHTML,
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/settings.html", map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"groups":     groupsHTML,
+	})
+}
+
+// Returns single setting's HTML with input, effective value's source and
+// value from configuration file.
+func settingsItem(ec echo.Context, s *settings.Setting) string {
+	value := settings.Get(s.Key)
+	fileValue := settings.FileValue(s)
+
+	source := settingsSources[value.Source]
+	sourceName := source[0]
+	if value.Source == settings.SourceEnvironment {
+		sourceName += " (" + s.EnvironmentVariable() + ")"
+	}
+
+	var disabled string
+	if value.Source == settings.SourceEnvironment {
+		disabled = " disabled"
+	}
+
+	name := html.EscapeString(s.Key)
+
+	var input string
+	switch {
+	case s.Type == settings.TypeBool:
+		var checked string
+		if settings.Bool(s.Key) {
+			checked = " checked"
+		}
+		input = "<label class=\"checkbox\"><input type=\"checkbox\" name=\"" + name + "\"" + checked + disabled + "> Enabled</label>"
+	case s.Secret:
+		placeholder := "Not set"
+		if value.Value != "" {
+			placeholder = "Set, hidden"
+		}
+		input = "<input class=\"input\" type=\"password\" name=\"" + name + "\" placeholder=\"" + placeholder + "\"" + disabled + ">"
+	case s.Type == settings.TypeInt:
+		input = "<input class=\"input\" type=\"number\" name=\"" + name + "\" value=\"" + html.EscapeString(value.Value) + "\"" + disabled + ">"
+	default:
+		input = "<input class=\"input\" type=\"text\" name=\"" + name + "\" value=\"" + html.EscapeString(value.Value) + "\"" + disabled + ">"
+	}
+
+	// Overridden in database setting might be reset to value from
+	// configuration file.
+	var reset string
+	if value.Source == settings.SourceDatabase {
+		reset = "<label class=\"checkbox\"><input type=\"checkbox\" name=\"reset\" value=\"" + name + "\"> Reset to " + settingsSources[fileValue.Source][0] + " value</label>"
+	}
+
+	return templater.GetRawTemplate(ec, "admin/settings_item.html", map[string]string{
+		"setting.key":          name,
+		"setting.name":         html.EscapeString(s.Name),
+		"setting.description":  html.EscapeString(s.Description),
+		"setting.input":        input,
+		"setting.source":       sourceName,
+		"setting.source_class": source[1],
+		"setting.file_value":   describeSettingValue(s, fileValue.Value) + " (" + settingsSources[fileValue.Source][0] + ")",
+		"setting.reset":        reset,
+	})
+}
+
+// Returns HTML-escaped value which is safe to show.
+func describeSettingValue(s *settings.Setting, value string) string {
+	if s.Secret {
+		if value == "" {
+			return "not set"
+		}
+
+		return "set"
+	}
+
+	if value == "" {
+		return "empty"
+	}
+
+	return html.EscapeString(value)
+}
+
+// Saves settings of one group. All values are validated before anything
+// is saved, and only changed values are stored in database.
+func settingsPOST(ec echo.Context) error {
+	form, err := ec.FormParams()
+	if err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	group := form.Get("group")
+	uid := ec.Get("UID").(int)
+
+	reset := make(map[string]bool)
+	for _, key := range form["reset"] {
+		reset[key] = true
+	}
+
+	var errors []string
+	var successes []string
+
+	changes := make(map[string]string)
+	var resets []*settings.Setting
+
+	for _, s := range settings.Definitions() {
+		if s.Group != group {
+			continue
+		}
+
+		current := settings.Get(s.Key)
+		if current.Source == settings.SourceEnvironment {
+			continue
+		}
+
+		if reset[s.Key] {
+			if current.Source == settings.SourceDatabase {
+				resets = append(resets, s)
+			}
+			continue
+		}
+
+		// Empty secret means "keep current".
+		raw := form.Get(s.Key)
+		if s.Secret && raw == "" {
+			continue
+		}
+
+		value := s.Normalize(raw)
+		if value == current.Value {
+			continue
+		}
+
+		if err1 := s.Validate(value); err1 != nil {
+			errors = append(errors, html.EscapeString(s.Name)+": "+html.EscapeString(err1.Error())+".")
+			continue
+		}
+
+		changes[s.Key] = value
+	}
+
+	if len(errors) == 0 {
+		for _, s := range resets {
+			before := auditSetting(s)
+			if err1 := settings.Reset(s.Key); err1 != nil {
+				errors = append(errors, "Failed to reset "+html.EscapeString(s.Name)+": "+html.EscapeString(err1.Error()))
+			} else {
+				audit.Log(ec, settingEvent(audit.ActionSettingReset, s), before, auditSetting(s))
+				successes = append(successes, html.EscapeString(s.Name)+" was reset.")
+			}
+		}
+
+		for key, value := range changes {
+			s := settings.GetDefinition(key)
+			before := auditSetting(s)
+			if err1 := settings.Set(key, value, uid); err1 != nil {
+				errors = append(errors, "Failed to save "+html.EscapeString(s.Name)+": "+html.EscapeString(err1.Error()))
+			} else {
+				audit.Log(ec, settingEvent(audit.ActionSettingUpdate, s), before, auditSetting(s))
+				successes = append(successes, html.EscapeString(s.Name)+" was saved.")
+			}
+		}
+
+		if len(errors) == 0 && len(successes) == 0 {
+			successes = append(successes, "Nothing was changed.")
+		}
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	return ec.HTML(status, adminPage(ec, "settings", settingsTab(ec, errors, successes)))
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/proxy"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many latest snapshots are shown.
+const snapshotsShown = 200
+
+type SnapshotRequest struct {
+	Action string `form:"action"`
+	ID     int    `form:"id"`
+}
+
+// Returns module proxy tab's HTML.
+func snapshotsTab(ec echo.Context, errors []string, successes []string) string {
+	f := &proxy.SnapshotsFilter{
+		Module:            strings.TrimSpace(ec.QueryParam("module")),
+		DiscrepanciesOnly: ec.QueryParam("discrepancies") == "1",
+	}
+
+	var snapshotsHTML string
+	for _, s := range proxy.GetSnapshots(f, snapshotsShown) {
+		upstream := "same"
+		if s.Discrepancy != "" {
+			upstream = `<span class="tag is-danger">` + s.Discrepancy + `</span>`
+		}
+
+		var checkedAt string
+		if s.CheckedAt != nil {
+			checkedAt = "checked " + s.CheckedAt.Format("2006-01-02 15:04:05")
+		}
+
+		size := formatSize(s.Size)
+		if !s.Stored {
+			size += " (removed by retention)"
+		}
+
+		pinAction, pinLabel, pinClass := "pin", "Pin", "is-info"
+		if s.Pinned {
+			pinAction, pinLabel, pinClass = "unpin", "Unpin", "is-warning"
+		}
+
+		snapshotsHTML += templater.GetRawTemplate(ec, "admin/snapshots_item.html", map[string]string{
+			"snapshot.id":             strconv.Itoa(s.ID),
+			"snapshot.module":         html.EscapeString(s.Module),
+			"snapshot.version":        html.EscapeString(s.Version),
+			"snapshot.time":           s.Time.Format("2006-01-02 15:04:05"),
+			"snapshot.size":           size,
+			"snapshot.created_at":     s.CreatedAt.Format("2006-01-02 15:04:05"),
+			"snapshot.last_served_at": s.LastServedAt.Format("2006-01-02 15:04:05"),
+			"snapshot.upstream":       upstream,
+			"snapshot.checked_at":     checkedAt,
+			"snapshot.pin_action":     pinAction,
+			"snapshot.pin_label":      pinLabel,
+			"snapshot.pin_class":      pinClass,
+		})
+	}
+
+	status := "enabled"
+	if !proxy.Enabled() {
+		status = "disabled (see <code>features.proxy</code> setting and <code>proxy.storage_path</code> in configuration file)"
+	}
+
+	retention := "Snapshots are kept forever."
+	if days := settings.Int("proxy.retention_days"); days > 0 {
+		retention = "Files of unpinned snapshots which weren't downloaded for " + strconv.Itoa(days) + " days are removed, their checksums are kept, so version fetched again must be identical."
+	}
+
+	var discrepancies string
+	if f.DiscrepanciesOnly {
+		discrepancies = "checked"
+	}
+
+	return templater.GetRawTemplate(ec, "admin/snapshots.html", map[string]string{
+		"errorsDiv":               templater.GetErrorFlash(ec, errors),
+		"successDiv":              templater.GetSuccessFlash(ec, successes),
+		"proxy.status":            status,
+		"proxy.url":               html.EscapeString(strings.TrimSuffix(config.Config.HTTP.Domain, "/") + "/proxy/"),
+		"proxy.retention":         retention,
+		"filter.module":           html.EscapeString(f.Module),
+		"filter.discrepancies":    discrepancies,
+		"snapshots.discrepancies": strconv.Itoa(proxy.CountDiscrepancies()),
+		"snapshots":               snapshotsHTML,
+	})
+}
+
+// Pins and unpins snapshots and compares them with upstream.
+func snapshotsPOST(ec echo.Context) error {
+	req := &SnapshotRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	s := proxy.GetSnapshotByID(req.ID)
+	if s == nil {
+		errors = append(errors, "Snapshot wasn't found.")
+	} else if req.Action == "pin" || req.Action == "unpin" {
+		action := audit.ActionSnapshotPin
+		if req.Action == "unpin" {
+			action = audit.ActionSnapshotUnpin
+		}
+
+		before := s.AuditData()
+		if err := s.SetPinned(req.Action == "pin"); err != nil {
+			errors = append(errors, "Failed to "+req.Action+" snapshot: "+html.EscapeString(err.Error()))
+		} else {
+			audit.Log(ec, snapshotEvent(action, s), before, s.AuditData())
+			successes = append(successes, "Snapshot of "+html.EscapeString(s.Module+"@"+s.Version)+" was "+req.Action+"ned.")
+		}
+	} else if req.Action == "check" {
+		if err := proxy.CheckModule(s.Module); err != nil {
+			errors = append(errors, "Failed to compare "+html.EscapeString(s.Module)+" with upstream: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, html.EscapeString(s.Module)+" was compared with upstream.")
+		}
+	} else {
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	return ec.HTML(status, adminPage(ec, "snapshots", snapshotsTab(ec, errors, successes)))
+}
+
+// Formats size in bytes as "1.5 MiB".
+func formatSize(size int64) string {
+	units := []string{"B", "KiB", "MiB", "GiB"}
+	value := float64(size)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return strconv.FormatInt(size, 10) + " B"
+	}
+
+	return strconv.FormatFloat(value, 'f', 1, 64) + " " + units[unit]
+}
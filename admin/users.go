@@ -0,0 +1,370 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many users are shown on one page.
+const usersPerPage = 20
+
+type UserRequest struct {
+	Action             string `form:"action"`
+	ID                 int    `form:"id"`
+	Login              string `form:"login"`
+	Email              string `form:"email"`
+	Role               string `form:"role"`
+	Password           string `form:"password"`
+	Active             string `form:"active"`
+	MustChangePassword string `form:"must_change_password"`
+	Confirm            bool   `form:"confirm"`
+}
+
+// Returns users tab's HTML: user's page if "id" query parameter is
+// passed, users list otherwise.
+func usersTab(ec echo.Context) string {
+	if id, _ := strconv.Atoi(ec.QueryParam("id")); id != 0 {
+		u := users.GetUserByID(id)
+		if u == nil {
+			return usersListTab(ec, []string{"User wasn't found."}, nil)
+		}
+
+		return userTab(ec, u, nil, nil)
+	}
+
+	return usersListTab(ec, nil, nil)
+}
+
+// Returns searchable paginated users list.
+func usersListTab(ec echo.Context, errors []string, successes []string) string {
+	query := strings.TrimSpace(ec.QueryParam("q"))
+	page, _ := strconv.Atoi(ec.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	total := users.CountUsers(query)
+	pages := (total + usersPerPage - 1) / usersPerPage
+	if pages == 0 {
+		pages = 1
+	}
+	if page > pages {
+		page = pages
+	}
+
+	var usersHTML string
+	for _, u := range users.SearchUsers(query, (page-1)*usersPerPage, usersPerPage) {
+		usersHTML += templater.GetRawTemplate(ec, "admin/users_item.html", userData(u))
+	}
+
+	var awaiting string
+	if count := users.CountUsersAwaitingApproval(); count != 0 {
+		awaiting = templater.GetRawTemplate(ec, "admin/users_awaiting.html", map[string]string{"count": strconv.Itoa(count)})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/users.html", map[string]string{
+		"awaiting":   awaiting,
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"query":      html.EscapeString(query),
+		"roles":      roleOptions(users.DefaultRole),
+		"total":      strconv.Itoa(total),
+		"users":      usersHTML,
+		"pagination": pagination(ec, "/admin/users/", url.Values{"q": {query}}, page, pages),
+	})
+}
+
+// Returns user's data for templates.
+func userData(u *users.User) map[string]string {
+	status := "active"
+	switch {
+	case u.RegistrationState == users.RegistrationAwaitingApproval:
+		status = "awaiting approval"
+	case u.RegistrationState == users.RegistrationAwaitingEmail:
+		status = "awaiting email confirmation"
+	case !u.IsActive:
+		status = "inactive"
+	}
+	if u.MustChangePassword {
+		status += ", must change password"
+	}
+	if u.IsLocked() {
+		status += ", locked"
+	}
+
+	twoFactor := "disabled"
+	if u.TOTPEnabled {
+		twoFactor = "enabled"
+	} else if u.IsTwoFactorRequired() {
+		twoFactor = "disabled, required by role"
+	}
+
+	lastLogin := "never"
+	if u.LastLoginAt != nil {
+		lastLogin = u.LastLoginAt.Format("2006-01-02 15:04:05")
+	}
+
+	return map[string]string{
+		"user.id":         strconv.Itoa(u.ID),
+		"user.login":      html.EscapeString(u.Login),
+		"user.email":      html.EscapeString(u.Email),
+		"user.role":       u.Role,
+		"user.provider":   u.AuthProvider,
+		"user.status":     status,
+		"user.2fa":        twoFactor,
+		"user.last_login": lastLogin,
+		"user.sessions":   strconv.Itoa(sessionkeys.CountActiveSessions(u.ID)),
+		"user.registered": u.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// Returns user's page.
+func userTab(ec echo.Context, u *users.User, errors []string, successes []string) string {
+	data := userData(u)
+	data["errorsDiv"] = templater.GetErrorFlash(ec, errors)
+	data["successDiv"] = templater.GetSuccessFlash(ec, successes)
+
+	data["user.roles"] = roleOptions(u.Role)
+
+	data["user.unlock.hidden"] = "is-hidden"
+	if u.IsLocked() {
+		data["user.unlock.hidden"] = ""
+		data["user.failed_logins"] = strconv.Itoa(u.FailedLogins)
+		data["user.locked_until"] = u.LockedUntil.Format("2006-01-02 15:04:05")
+	}
+
+	data["user.toggle_action"] = "deactivate"
+	data["user.toggle"] = "Deactivate"
+	if !u.IsActive {
+		data["user.toggle_action"] = "activate"
+		data["user.toggle"] = "Activate"
+	}
+
+	return templater.GetRawTemplate(ec, "admin/user.html", data)
+}
+
+// Returns options for role select with passed role selected.
+func roleOptions(selected string) string {
+	var options string
+	for _, role := range users.Roles {
+		var attr string
+		if role == selected {
+			attr = " selected"
+		}
+		options += "<option value=\"" + role + "\"" + attr + ">" + role + "</option>"
+	}
+
+	return options
+}
+
+// Returns reason why passed action can't be done on passed user or
+// empty string if it can. Nobody can lock himself out, and at least
+// one active admin should remain to be able to manage MAGISTER.
+func lockoutReason(ec echo.Context, u *users.User, action string) string {
+	if u.ID == ec.Get("UID").(int) {
+		return "You can't do this with your own account."
+	}
+
+	if action == "force_reset" && !u.IsLocal() {
+		return "Password of this user is managed by " + u.AuthProvider + "."
+	}
+
+	if action == "force_reset" || action == "reset_2fa" || !u.IsActive {
+		return ""
+	}
+
+	// Role change doesn't prevent user from logging in.
+	if action != "change_role" && users.CountActiveUsers() <= 1 {
+		return "This is the last active user."
+	}
+
+	if u.Role == users.RoleAdmin && users.CountActiveAdmins() <= 1 {
+		return "This is the last active admin."
+	}
+
+	return ""
+}
+
+// Handles users forms.
+func usersPOST(ec echo.Context) error {
+	req := &UserRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	// Creation is the only action which doesn't need existing user.
+	if req.Action == "create" {
+		u := &users.User{
+			Login:              strings.TrimSpace(req.Login),
+			Email:              strings.TrimSpace(req.Email),
+			Role:               req.Role,
+			IsActive:           req.Active != "",
+			MustChangePassword: req.MustChangePassword != "",
+			CreatedAt:          time.Now().UTC(),
+			UpdatedAt:          time.Now().UTC(),
+		}
+
+		if req.Password == "" {
+			errors = append(errors, "Password should not be empty.")
+		} else if err := u.Validate(); err != nil {
+			errors = append(errors, "Invalid user: "+html.EscapeString(err.Error())+".")
+		} else if err1 := u.CreatePassword(req.Password); err1 != nil {
+			errors = append(errors, "Failed to set password: "+html.EscapeString(err1.Error()))
+		} else if err2 := u.Create(); err2 != nil {
+			errors = append(errors, "Failed to create user: "+html.EscapeString(err2.Error()))
+		}
+
+		if len(errors) != 0 {
+			return ec.HTML(http.StatusBadRequest, adminPage(ec, "users", usersListTab(ec, errors, nil)))
+		}
+
+		audit.Log(ec, userEvent(audit.ActionUserCreate, u), nil, u.AuditData())
+		return ec.HTML(http.StatusOK, adminPage(ec, "users", userTab(ec, u, nil, []string{"User created."})))
+	}
+
+	u := users.GetUserByID(req.ID)
+	if u == nil {
+		return ec.HTML(http.StatusBadRequest, adminPage(ec, "users", usersListTab(ec, []string{"User wasn't found."}, nil)))
+	}
+
+	// User's state before action, for audit log.
+	before := u.AuditData()
+
+	switch req.Action {
+	case "save":
+		if req.Role != "" && req.Role != u.Role {
+			if reason := lockoutReason(ec, u, "change_role"); reason != "" {
+				errors = append(errors, reason)
+				break
+			}
+			u.Role = req.Role
+		}
+
+		u.Login = strings.TrimSpace(req.Login)
+		u.Email = strings.TrimSpace(req.Email)
+		if err := u.Validate(); err != nil {
+			errors = append(errors, "Invalid user: "+html.EscapeString(err.Error())+".")
+		} else {
+			u.Save()
+			audit.Log(ec, userEvent(audit.ActionUserUpdate, u), before, u.AuditData())
+			successes = append(successes, "User saved.")
+		}
+	case "activate":
+		if u.RegistrationState == users.RegistrationAwaitingApproval {
+			users.NotifyApproved(u)
+		}
+		u.SetActive()
+		audit.Log(ec, userEvent(audit.ActionUserActivate, u), before, u.AuditData())
+		successes = append(successes, "User activated.")
+	case "unlock":
+		u.Unlock()
+		audit.Log(ec, userEvent(audit.ActionUserUnlock, u), before, u.AuditData())
+		successes = append(successes, "User unlocked.")
+	case "deactivate", "force_reset", "reset_2fa", "delete":
+		if reason := lockoutReason(ec, u, req.Action); reason != "" {
+			errors = append(errors, reason)
+			break
+		}
+
+		switch {
+		case req.Action == "deactivate":
+			u.IsActive = false
+			u.Save()
+			if err := sessionkeys.DeleteUserSessions(u.ID); err != nil {
+				log.Error().Msgf("Failed to delete sessions of user #%d: %s", u.ID, err.Error())
+			}
+			audit.Log(ec, userEvent(audit.ActionUserDeactivate, u), before, u.AuditData())
+			successes = append(successes, "User deactivated and logged out everywhere.")
+		case req.Action == "force_reset":
+			if req.Password != "" {
+				if err := u.CreatePassword(req.Password); err != nil {
+					errors = append(errors, "Failed to set temporary password: "+html.EscapeString(err.Error()))
+					break
+				}
+			}
+			u.MustChangePassword = true
+			u.Save()
+			if err := sessionkeys.DeleteUserSessions(u.ID); err != nil {
+				log.Error().Msgf("Failed to delete sessions of user #%d: %s", u.ID, err.Error())
+			}
+			after := u.AuditData()
+			after["temporary_password_set"] = req.Password != ""
+			audit.Log(ec, userEvent(audit.ActionUserForceReset, u), before, after)
+			successes = append(successes, "User was logged out everywhere and must change password after next login.")
+		case req.Action == "reset_2fa":
+			if err := u.DisableTwoFactor(); err != nil {
+				errors = append(errors, "Failed to reset two-factor authentication: "+html.EscapeString(err.Error()))
+				break
+			}
+			audit.Log(ec, userEvent(audit.ActionUserTwoFactorReset, u), before, u.AuditData())
+			successes = append(successes, "Two-factor authentication was reset, user will be asked only for password on next login.")
+		case !req.Confirm:
+			message := "Delete user <b>" + html.EscapeString(u.Login) + "</b> (" + html.EscapeString(u.Email) + ")?"
+			return ec.HTML(http.StatusOK, adminPage(ec, "users", confirmTab(ec, message, "/admin/users/", "/admin/users/?id="+strconv.Itoa(u.ID), map[string]string{
+				"action": "delete",
+				"id":     strconv.Itoa(u.ID),
+			})))
+		default:
+			if err := u.Delete(); err != nil {
+				errors = append(errors, "Failed to delete user: "+html.EscapeString(err.Error()))
+				break
+			}
+
+			audit.Log(ec, userEvent(audit.ActionUserDelete, u), before, nil)
+			return ec.HTML(http.StatusOK, adminPage(ec, "users", usersListTab(ec, nil, []string{"User " + html.EscapeString(u.Login) + " deleted."})))
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	// User is re-read, failed save shouldn't show unsaved data as saved.
+	if fresh := users.GetUserByID(u.ID); fresh != nil {
+		u = fresh
+	}
+
+	return ec.HTML(status, adminPage(ec, "users", userTab(ec, u, errors, successes)))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/vulns"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns vulnerabilities tab's HTML: served packages which versions
+// are affected by imported advisories.
+func vulnerabilitiesTab(ec echo.Context) string {
+	var rows string
+	var affected int
+	for _, pkg := range packages.GetPackages() {
+		var isAffected bool
+		for _, m := range pkg.ServedVersions() {
+			for _, entry := range vulns.AffectingVersions(m.Module, m.Versions) {
+				isAffected = true
+				rows += templater.GetRawTemplate(ec, "admin/vulnerability.html", map[string]string{
+					"vulnerability.module":   html.EscapeString(m.Module),
+					"vulnerability.id":       html.EscapeString(entry.ID),
+					"vulnerability.aliases":  html.EscapeString(strings.Join(entry.Aliases, ", ")),
+					"vulnerability.summary":  html.EscapeString(entry.Summary),
+					"vulnerability.affected": html.EscapeString(entry.DescribeAffected(m.Module, m.Versions)),
+					"vulnerability.ranges":   html.EscapeString(strings.Join(entry.DescribeRanges(m.Module), "; ")),
+				})
+			}
+		}
+
+		if isAffected {
+			affected++
+		}
+	}
+
+	return templater.GetRawTemplate(ec, "admin/vulnerabilities.html", map[string]string{
+		"vulnerabilities":          rows,
+		"vulnerabilities.total":    strconv.Itoa(len(vulns.GetVulnerabilities())),
+		"vulnerabilities.affected": strconv.Itoa(affected),
+	})
+}
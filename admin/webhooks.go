@@ -0,0 +1,197 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package admin
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How many latest deliveries are shown.
+const webhooksDeliveriesShown = 50
+
+type WebhookRequest struct {
+	Action string `form:"action"`
+	ID     int    `form:"id"`
+	Name   string `form:"name"`
+	URL    string `form:"url"`
+	Secret string `form:"secret"`
+}
+
+// Returns outgoing webhooks tab's HTML.
+func webhooksTab(ec echo.Context, errors []string, successes []string) string {
+	hooks := webhooks.GetWebhooks()
+	names := make(map[int]string)
+
+	var hooksHTML string
+	for _, hook := range hooks {
+		names[hook.ID] = hook.Name
+
+		toggle := "Disable"
+		status := "enabled"
+		if !hook.Enabled {
+			toggle = "Enable"
+			status = "disabled"
+		}
+
+		hooksHTML += templater.GetRawTemplate(ec, "admin/webhooks_item.html", map[string]string{
+			"webhook.id":     strconv.Itoa(hook.ID),
+			"webhook.name":   html.EscapeString(hook.Name),
+			"webhook.url":    html.EscapeString(hook.URL),
+			"webhook.events": html.EscapeString(strings.Replace(hook.Events, ",", ", ", -1)),
+			"webhook.signed": strconv.FormatBool(hook.Secret != ""),
+			"webhook.status": status,
+			"webhook.toggle": toggle,
+		})
+	}
+
+	var eventsHTML string
+	for _, event := range webhooks.Events {
+		eventsHTML += templater.GetRawTemplate(ec, "admin/webhooks_event.html", map[string]string{
+			"event.name":        event.Name,
+			"event.description": html.EscapeString(event.Description),
+		})
+	}
+
+	var deliveriesHTML string
+	for _, d := range webhooks.GetDeliveries(0, webhooksDeliveriesShown) {
+		name, found := names[d.WebhookID]
+		if !found {
+			name = "#" + strconv.Itoa(d.WebhookID)
+		}
+
+		var responseCode string
+		if d.ResponseCode != 0 {
+			responseCode = strconv.Itoa(d.ResponseCode)
+		}
+
+		var nextAttempt string
+		if d.Status == webhooks.StatusPending {
+			nextAttempt = "next attempt at " + d.NextAttemptAt.Format("2006-01-02 15:04:05")
+		}
+
+		deliveriesHTML += templater.GetRawTemplate(ec, "admin/webhooks_delivery.html", map[string]string{
+			"delivery.id":            strconv.Itoa(d.ID),
+			"delivery.created_at":    d.CreatedAt.Format("2006-01-02 15:04:05"),
+			"delivery.webhook":       html.EscapeString(name),
+			"delivery.event":         d.Event,
+			"delivery.status":        d.Status,
+			"delivery.attempts":      strconv.Itoa(d.Attempts),
+			"delivery.response_code": responseCode,
+			"delivery.error":         html.EscapeString(d.Error),
+			"delivery.next_attempt":  nextAttempt,
+		})
+	}
+
+	return templater.GetRawTemplate(ec, "admin/webhooks.html", map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"webhooks":   hooksHTML,
+		"events":     eventsHTML,
+		"deliveries": deliveriesHTML,
+	})
+}
+
+// Adds, deletes, enables or disables outgoing webhooks and sends test
+// events.
+func webhooksPOST(ec echo.Context) error {
+	req := &WebhookRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	var errors []string
+	var successes []string
+
+	var hook *webhooks.Webhook
+	if req.Action != "add" {
+		hook = webhooks.GetWebhookByID(req.ID)
+	}
+
+	switch {
+	case req.Action == "add":
+		var events []string
+		if params, err := ec.FormParams(); err == nil {
+			events = params["events"]
+		}
+
+		hook = &webhooks.Webhook{
+			Name:    strings.TrimSpace(req.Name),
+			URL:     strings.TrimSpace(req.URL),
+			Secret:  req.Secret,
+			Events:  strings.Join(events, ","),
+			Enabled: true,
+		}
+
+		if err := hook.Create(); err != nil {
+			errors = append(errors, "Failed to add webhook: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Webhook added.")
+		}
+	case hook == nil:
+		errors = append(errors, "Webhook wasn't found.")
+	case req.Action == "delete":
+		if err := hook.Delete(); err != nil {
+			errors = append(errors, "Failed to delete webhook: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Webhook deleted.")
+		}
+	case req.Action == "toggle":
+		hook.Enabled = !hook.Enabled
+		if err := hook.Save(); err != nil {
+			errors = append(errors, "Failed to save webhook: "+html.EscapeString(err.Error()))
+		} else if hook.Enabled {
+			successes = append(successes, "Webhook enabled.")
+		} else {
+			successes = append(successes, "Webhook disabled.")
+		}
+	case req.Action == "test":
+		d, err := webhooks.SendTest(hook)
+		if err != nil {
+			errors = append(errors, "Failed to send test event: "+html.EscapeString(err.Error()))
+		} else if d.Status != webhooks.StatusDelivered {
+			errors = append(errors, "Test event wasn't delivered: "+html.EscapeString(d.Error))
+		} else {
+			successes = append(successes, "Test event delivered, response status "+strconv.Itoa(d.ResponseCode)+".")
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	return ec.HTML(status, adminPage(ec, "webhooks", webhooksTab(ec, errors, successes)))
+}
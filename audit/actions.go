@@ -0,0 +1,95 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audit
+
+// Audited actions.
+const (
+	ActionPackageCreate = "package.create"
+	ActionPackageUpdate = "package.update"
+	ActionPackageState  = "package.state"
+	ActionPackageDelete = "package.delete"
+
+	ActionURLCreate = "url.create"
+	ActionURLUpdate = "url.update"
+	ActionURLMove   = "url.move"
+	ActionURLDelete = "url.delete"
+
+	ActionSnapshotPin   = "snapshot.pin"
+	ActionSnapshotUnpin = "snapshot.unpin"
+
+	ActionUserCreate         = "user.create"
+	ActionUserRegister       = "user.register"
+	ActionUserProvision      = "user.provision"
+	ActionUserSync           = "user.sync"
+	ActionUserUpdate         = "user.update"
+	ActionUserActivate       = "user.activate"
+	ActionUserDeactivate     = "user.deactivate"
+	ActionUserForceReset     = "user.force_reset"
+	ActionUserTwoFactorReset = "user.2fa_reset"
+	ActionUserLock           = "user.lock"
+	ActionUserUnlock         = "user.unlock"
+	ActionUserDelete         = "user.delete"
+
+	ActionLogin          = "auth.login"
+	ActionLoginFailed    = "auth.login_failed"
+	ActionLogout         = "auth.logout"
+	ActionPasswordChange = "auth.password_change"
+	ActionPasswordReset  = "auth.password_reset"
+
+	ActionTwoFactorEnable         = "auth.2fa_enable"
+	ActionTwoFactorDisable        = "auth.2fa_disable"
+	ActionRecoveryCodesRegenerate = "auth.recovery_codes_regenerate"
+	ActionRecoveryCodeUsed        = "auth.recovery_code_used"
+
+	ActionSessionRevoke       = "session.revoke"
+	ActionSessionRevokeOthers = "session.revoke_others"
+
+	ActionTokenCreate = "token.create"
+	ActionTokenRevoke = "token.revoke"
+
+	ActionSettingUpdate = "settings.update"
+	ActionSettingReset  = "settings.reset"
+)
+
+// Types of objects actions are made on.
+const (
+	TargetPackage  = "package"
+	TargetURL      = "url"
+	TargetSnapshot = "snapshot"
+	TargetUser     = "user"
+	TargetSetting  = "setting"
+	TargetSession  = "session"
+	TargetToken    = "token"
+)
+
+// Actions lists all audited actions in order they should be shown.
+var Actions = []string{
+	ActionPackageCreate, ActionPackageUpdate, ActionPackageState, ActionPackageDelete,
+	ActionURLCreate, ActionURLUpdate, ActionURLMove, ActionURLDelete,
+	ActionSnapshotPin, ActionSnapshotUnpin,
+	ActionUserCreate, ActionUserRegister, ActionUserProvision, ActionUserSync, ActionUserUpdate, ActionUserActivate, ActionUserDeactivate, ActionUserForceReset, ActionUserTwoFactorReset, ActionUserLock, ActionUserUnlock, ActionUserDelete,
+	ActionLogin, ActionLoginFailed, ActionLogout, ActionPasswordChange, ActionPasswordReset,
+	ActionTwoFactorEnable, ActionTwoFactorDisable, ActionRecoveryCodesRegenerate, ActionRecoveryCodeUsed,
+	ActionSessionRevoke, ActionSessionRevokeOthers,
+	ActionTokenCreate, ActionTokenRevoke,
+	ActionSettingUpdate, ActionSettingReset,
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audit
+
+import (
+	// stdlib
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	h "github.com/welltrainedfolks/magister/internal/http"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Actor login for actions made with magisterctl.
+const ActorCLI = "magisterctl"
+
+// Event is a single audit log entry.
+type Event struct {
+	ID         int    `db:"id" json:"id"`
+	ActorID    int    `db:"actor_id" json:"actor_id"`
+	ActorLogin string `db:"actor_login" json:"actor_login"`
+	IP         string `db:"ip" json:"ip"`
+	Action     string `db:"action" json:"action"`
+	TargetType string `db:"target_type" json:"target_type"`
+	TargetID   int    `db:"target_id" json:"target_id"`
+	Target     string `db:"target" json:"target"`
+	// JSON objects with changed fields only. Empty for actions which
+	// don't change anything (e.g. login).
+	Before    string    `db:"before" json:"before"`
+	After     string    `db:"after" json:"after"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// Filter describes which events should be returned. Empty fields match
+// everything.
+type Filter struct {
+	// Action or actions group, e.g. "package.update" or "package".
+	Action string
+	// Actor's login.
+	Actor string
+	// Part of target's name.
+	Target string
+	From   time.Time
+	To     time.Time
+}
+
+// Log records event. Actor and IP are taken from request unless event
+// already has them, nil context means action made with magisterctl.
+// Before and after states are stored as diff: only fields which were
+// changed are kept. Logging failures don't break action which is
+// logged, they're only reported.
+func Log(ec echo.Context, event *Event, before interface{}, after interface{}) {
+	if ec == nil {
+		if event.ActorLogin == "" {
+			event.ActorLogin = ActorCLI
+		}
+	} else {
+		authorized, _ := ec.Get("AUTHORIZED").(bool)
+		if event.ActorID == 0 && authorized {
+			event.ActorID, _ = ec.Get("UID").(int)
+		}
+
+		if event.ActorLogin == "" && event.ActorID != 0 {
+			event.ActorLogin = getLogin(event.ActorID)
+		}
+
+		if event.IP == "" {
+			if ip := h.ClientIP(ec.Request()); ip != nil {
+				event.IP = ip.String()
+			}
+		}
+	}
+
+	event.Before, event.After = diff(before, after)
+	event.CreatedAt = time.Now().UTC()
+
+	_, err := database.DB.NamedExec("INSERT INTO `audit_log` (actor_id, actor_login, ip, action, target_type, target_id, target, `before`, `after`, created_at) VALUES (:actor_id, :actor_login, :ip, :action, :target_type, :target_id, :target, :before, :after, :created_at)", event)
+	if err != nil {
+		log.Error().Msgf("Failed to write audit log event '%s' for '%s': %s", event.Action, event.Target, err.Error())
+	}
+}
+
+// Returns login of user with passed ID. Users module can't be used
+// here, because it logs it's own events.
+func getLogin(uid int) string {
+	var login string
+	err := database.DB.Get(&login, database.DB.Rebind("SELECT login FROM `users` WHERE id=?"), uid)
+	if err != nil {
+		log.Error().Msgf("Failed to get login of user #%d for audit log: %s", uid, err.Error())
+	}
+
+	return login
+}
+
+// Returns JSON objects with fields which differ between before and
+// after. If one of them is nil (object was created or deleted) - other
+// is returned as is.
+func diff(before interface{}, after interface{}) (string, string) {
+	beforeFields := toFields(before)
+	afterFields := toFields(after)
+
+	if beforeFields != nil && afterFields != nil {
+		for key, value := range beforeFields {
+			if afterValue, found := afterFields[key]; found && reflect.DeepEqual(value, afterValue) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+	}
+
+	return toJSON(beforeFields), toJSON(afterFields)
+}
+
+// Converts passed object to fields map.
+func toFields(object interface{}) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		log.Error().Msgf("Failed to encode audit log object: %s", err.Error())
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	if err1 := json.Unmarshal(data, &fields); err1 != nil {
+		log.Error().Msgf("Audit log object isn't a JSON object: %s", err1.Error())
+		return nil
+	}
+
+	return fields
+}
+
+func toJSON(fields map[string]interface{}) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	data, _ := json.Marshal(fields)
+	return string(data)
+}
+
+// Returns WHERE clause and it's arguments for passed filter.
+func (f *Filter) where() (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if f.Action != "" {
+		conditions = append(conditions, "(action=? OR action LIKE ?)")
+		args = append(args, f.Action, database.EscapeLike(f.Action)+".%")
+	}
+
+	if f.Actor != "" {
+		conditions = append(conditions, "actor_login=?")
+		args = append(args, f.Actor)
+	}
+
+	if f.Target != "" {
+		conditions = append(conditions, "target LIKE ?")
+		args = append(args, "%"+database.EscapeLike(f.Target)+"%")
+	}
+
+	if !f.From.IsZero() {
+		conditions = append(conditions, "created_at>=?")
+		args = append(args, f.From)
+	}
+
+	if !f.To.IsZero() {
+		conditions = append(conditions, "created_at<?")
+		args = append(args, f.To)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// GetEvents returns page of events matching filter, newest first.
+// Negative limit returns all events.
+func GetEvents(f *Filter, offset int, limit int) []*Event {
+	where, args := f.where()
+	query := "SELECT * FROM `audit_log`" + where + " ORDER BY id DESC"
+	if limit >= 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, limit, offset)
+	}
+
+	events := []*Event{}
+	err := database.DB.Select(&events, database.DB.Rebind(query), args...)
+	if err != nil {
+		log.Error().Msgf("Failed to get audit log events: %s", err.Error())
+		return nil
+	}
+
+	return events
+}
+
+// CountEvents returns count of events matching filter.
+func CountEvents(f *Filter) int {
+	where, args := f.where()
+
+	var count int
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `audit_log`"+where), args...)
+	if err != nil {
+		log.Error().Msgf("Failed to count audit log events: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// DeleteEventsBefore deletes events older than passed time and returns
+// how many were deleted.
+func DeleteEventsBefore(before time.Time) (int64, error) {
+	res, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `audit_log` WHERE created_at<?"), before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audit
+
+import (
+	// stdlib
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// How often old events are deleted.
+const cleanupInterval = time.Hour
+
+// Initialize initializes package and starts deleting events which are
+// older than retention period.
+func Initialize() {
+	log.Info().Msg("Initializing audit log...")
+
+	go func() {
+		for {
+			cleanup()
+			time.Sleep(cleanupInterval)
+		}
+	}()
+}
+
+// Deletes events which are older than retention period.
+func cleanup() {
+	days := settings.Int("audit.retention_days")
+	if days <= 0 {
+		return
+	}
+
+	deleted, err := DeleteEventsBefore(time.Now().UTC().AddDate(0, 0, -days))
+	if err != nil {
+		log.Error().Msgf("Failed to delete old audit log events: %s", err.Error())
+		return
+	}
+
+	if deleted != 0 {
+		log.Info().Msgf("Deleted %d audit log events older than %d days", deleted, days)
+	}
+}
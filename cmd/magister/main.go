@@ -30,13 +30,21 @@ import (
 
 	// local
 	"github.com/welltrainedfolks/magister/admin"
+	"github.com/welltrainedfolks/magister/audit"
 	"github.com/welltrainedfolks/magister/common"
+	"github.com/welltrainedfolks/magister/hooks"
 	"github.com/welltrainedfolks/magister/internal/config"
 	"github.com/welltrainedfolks/magister/internal/database"
 	"github.com/welltrainedfolks/magister/internal/http"
 	"github.com/welltrainedfolks/magister/internal/mailsender"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/settings"
 	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/proxy"
 	"github.com/welltrainedfolks/magister/users"
+	"github.com/welltrainedfolks/magister/vulns"
+	"github.com/welltrainedfolks/magister/webhooks"
 
 	// other
 	"github.com/rs/zerolog"
@@ -57,12 +65,20 @@ func main() {
 	config.LoadConfiguration()
 	templater.Initialize()
 	database.Initialize()
+	settings.Initialize()
+	sessionkeys.Initialize()
 	http.Initialize()
 
 	// Initialize modules.
 	admin.Initialize()
+	audit.Initialize()
+	hooks.Initialize()
 	mailsender.Initialize()
+	packages.Initialize()
+	proxy.Initialize()
 	users.Initialize()
+	vulns.Initialize()
+	webhooks.Initialize()
 
 	// Start HTTP server.
 	http.StartListening()
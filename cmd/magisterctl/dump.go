@@ -0,0 +1,189 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"gopkg.in/yaml.v2"
+)
+
+// Registry dump format version. Should be increased every time dump
+// structure changes in backward-incompatible way.
+const dumpFormatVersion = 1
+
+// Dump represents whole registry dump which is produced by "export"
+// command and consumed by "import" command.
+type Dump struct {
+	FormatVersion   int           `json:"format_version" yaml:"format_version"`
+	MagisterVersion string        `json:"magister_version" yaml:"magister_version"`
+	ExportedAt      time.Time     `json:"exported_at" yaml:"exported_at"`
+	Packages        []DumpPackage `json:"packages" yaml:"packages"`
+	// Global routing policies.
+	RoutingPolicies []DumpRoutingPolicy `json:"routing_policies,omitempty" yaml:"routing_policies,omitempty"`
+	Users           []DumpUser          `json:"users" yaml:"users"`
+}
+
+// DumpPackage represents single package in registry dump.
+type DumpPackage struct {
+	Name               string           `json:"name" yaml:"name"`
+	OriginalPackageURL string           `json:"original_package_url" yaml:"original_package_url"`
+	State              string           `json:"state,omitempty" yaml:"state,omitempty"`
+	StateReason        string           `json:"state_reason,omitempty" yaml:"state_reason,omitempty"`
+	CreatedAt          time.Time        `json:"created_at" yaml:"created_at"`
+	URLs               []DumpPackageURL `json:"urls" yaml:"urls"`
+	// Package's own routing policies.
+	RoutingPolicies []DumpRoutingPolicy `json:"routing_policies,omitempty" yaml:"routing_policies,omitempty"`
+}
+
+// DumpPackageURL represents single package's sources URL in registry
+// dump.
+type DumpPackageURL struct {
+	URL     string `json:"url" yaml:"url"`
+	VCS     string `json:"vcs,omitempty" yaml:"vcs,omitempty"`
+	Mirror  string `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// DumpRoutingPolicy represents single mirror routing policy in registry
+// dump.
+type DumpRoutingPolicy struct {
+	Priority   int    `json:"priority" yaml:"priority"`
+	MatchType  string `json:"match_type" yaml:"match_type"`
+	MatchValue string `json:"match_value" yaml:"match_value"`
+	Mirror     string `json:"mirror" yaml:"mirror"`
+	Enabled    bool   `json:"enabled" yaml:"enabled"`
+}
+
+// Converts routing policies to their dump representation.
+func dumpRoutingPolicies(policies []*packages.RoutingPolicy) []DumpRoutingPolicy {
+	var dumped []DumpRoutingPolicy
+	for _, rp := range policies {
+		dumped = append(dumped, DumpRoutingPolicy{
+			Priority:   rp.Priority,
+			MatchType:  rp.MatchType,
+			MatchValue: rp.MatchValue,
+			Mirror:     rp.Mirror,
+			Enabled:    rp.Enabled,
+		})
+	}
+
+	return dumped
+}
+
+// DumpUser represents single user in registry dump. Password hash and
+// salt are present only if export was requested with them, salt is
+// present only for legacy hashes. Empty auth provider means local user.
+// OpenID Connect issuer and subject are present only for oidc users.
+type DumpUser struct {
+	Login        string    `json:"login" yaml:"login"`
+	Email        string    `json:"email" yaml:"email"`
+	Role         string    `json:"role,omitempty" yaml:"role,omitempty"`
+	AuthProvider string    `json:"auth_provider,omitempty" yaml:"auth_provider,omitempty"`
+	OIDCIssuer   string    `json:"oidc_issuer,omitempty" yaml:"oidc_issuer,omitempty"`
+	OIDCSubject  string    `json:"oidc_subject,omitempty" yaml:"oidc_subject,omitempty"`
+	IsActive     bool      `json:"is_active" yaml:"is_active"`
+	CreatedAt    time.Time `json:"created_at" yaml:"created_at"`
+	Password     string    `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordSalt string    `json:"password_salt,omitempty" yaml:"password_salt,omitempty"`
+}
+
+// Detects dump format. Explicitly passed format wins, otherwise it is
+// guessed from file extension. JSON is a default.
+func detectDumpFormat(format string, path string) (string, error) {
+	if format == "" {
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext == ".yaml" || ext == ".yml" {
+			return "yaml", nil
+		}
+		return "json", nil
+	}
+
+	if format != "json" && format != "yaml" {
+		return "", errors.New("unknown dump format '" + format + "', should be 'json' or 'yaml'")
+	}
+
+	return format, nil
+}
+
+// Reads dump from file. "-" means standard input.
+func readDump(path string, format string) (*Dump, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Dump{}
+	if format == "yaml" {
+		err = yaml.Unmarshal(data, d)
+	} else {
+		err = json.Unmarshal(data, d)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if d.FormatVersion == 0 || d.FormatVersion > dumpFormatVersion {
+		return nil, errors.New("unsupported dump format version, this magisterctl supports versions up to " + strconv.Itoa(dumpFormatVersion))
+	}
+
+	return d, nil
+}
+
+// Writes dump to file. "-" or empty path means standard output.
+func writeDump(d *Dump, path string, format string) error {
+	var data []byte
+	var err error
+	if format == "yaml" {
+		data, err = yaml.Marshal(d)
+	} else {
+		data, err = json.MarshalIndent(d, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	if path == "" || path == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0600)
+}
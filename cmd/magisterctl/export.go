@@ -0,0 +1,137 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"flag"
+	"os"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/common"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/users"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Exports whole registry into file.
+func exportRegistry(args []string) {
+	var format string
+	var output string
+	var withPasswords bool
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.StringVar(&format, "format", "", "Dump format, 'json' or 'yaml'. If not specified it will be guessed from output file extension, JSON otherwise.")
+	fs.StringVar(&output, "output", "-", "File to write dump to. '-' means standard output.")
+	fs.BoolVar(&withPasswords, "with_passwords", false, "Also export users password hashes.")
+	fs.Parse(args)
+
+	format, err := detectDumpFormat(format, output)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	d := &Dump{
+		FormatVersion:   dumpFormatVersion,
+		MagisterVersion: common.VERSION,
+		ExportedAt:      time.Now().UTC(),
+		Packages:        []DumpPackage{},
+		Users:           []DumpUser{},
+	}
+
+	pkgs := packages.GetPackages()
+	if pkgs == nil {
+		log.Fatal().Msg("Failed to get packages list, export aborted")
+	}
+
+	for _, pkg := range pkgs {
+		dp := DumpPackage{
+			Name:               pkg.Name,
+			OriginalPackageURL: pkg.OriginalPackageURL,
+			State:              pkg.State,
+			StateReason:        pkg.StateReason,
+			CreatedAt:          pkg.CreatedAt,
+			URLs:               []DumpPackageURL{},
+		}
+
+		urls := pkg.GetURLs()
+		if urls == nil {
+			log.Fatal().Msgf("Failed to get URLs for package '%s', export aborted", pkg.OriginalPackageURL)
+		}
+
+		for _, url := range urls {
+			dp.URLs = append(dp.URLs, DumpPackageURL{URL: url.URL, VCS: url.VCS, Mirror: url.Mirror, Enabled: url.Enabled})
+		}
+
+		policies := packages.GetRoutingPolicies(pkg.ID)
+		if policies == nil {
+			log.Fatal().Msgf("Failed to get routing policies for package '%s', export aborted", pkg.OriginalPackageURL)
+		}
+		dp.RoutingPolicies = dumpRoutingPolicies(policies)
+
+		d.Packages = append(d.Packages, dp)
+	}
+
+	globalPolicies := packages.GetRoutingPolicies(0)
+	if globalPolicies == nil {
+		log.Fatal().Msg("Failed to get global routing policies, export aborted")
+	}
+	d.RoutingPolicies = dumpRoutingPolicies(globalPolicies)
+
+	usrs := users.GetUsers()
+	if usrs == nil {
+		log.Fatal().Msg("Failed to get users list, export aborted")
+	}
+
+	for _, u := range usrs {
+		du := DumpUser{
+			Login:     u.Login,
+			Email:     u.Email,
+			Role:      u.Role,
+			IsActive:  u.IsActive,
+			CreatedAt: u.CreatedAt,
+		}
+
+		if !u.IsLocal() {
+			du.AuthProvider = u.AuthProvider
+			du.OIDCIssuer = u.OIDCIssuer
+			du.OIDCSubject = u.OIDCSubject
+		}
+
+		if withPasswords {
+			du.Password = u.Password
+			du.PasswordSalt = u.PasswordSalt
+		}
+
+		d.Users = append(d.Users, du)
+	}
+
+	if err1 := writeDump(d, output, format); err1 != nil {
+		log.Error().Msgf("Failed to write dump: %s", err1.Error())
+		os.Exit(1)
+	}
+
+	log.Info().Msgf("Exported %d packages and %d users", len(d.Packages), len(d.Users))
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"flag"
+	"os"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/users"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Conflict resolution strategies for import.
+const (
+	conflictFail      = "fail"
+	conflictOverwrite = "overwrite"
+	conflictSkip      = "skip"
+)
+
+// Imports registry from dump. Importing same dump multiple times is
+// safe: packages are matched by original package URL and users are
+// matched by login, and already existing entries are handled according
+// to selected conflict strategy.
+func importRegistry(args []string) {
+	var format string
+	var input string
+	var conflict string
+
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	fs.StringVar(&format, "format", "", "Dump format, 'json' or 'yaml'. If not specified it will be guessed from input file extension, JSON otherwise.")
+	fs.StringVar(&input, "input", "", "File to read dump from. '-' means standard input.")
+	fs.StringVar(&conflict, "conflict", conflictSkip, "What to do with packages and users which already exist: 'skip', 'overwrite' or 'fail'.")
+	fs.Parse(args)
+
+	if input == "" {
+		log.Error().Msg("Dump file wasn't provided")
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if conflict != conflictSkip && conflict != conflictOverwrite && conflict != conflictFail {
+		log.Fatal().Msgf("Unknown conflict strategy '%s'", conflict)
+	}
+
+	format, err := detectDumpFormat(format, input)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	d, err1 := readDump(input, format)
+	if err1 != nil {
+		log.Fatal().Msgf("Failed to read dump: %s", err1.Error())
+	}
+
+	log.Info().Msgf("Importing dump made by MAGISTER %s at %s", d.MagisterVersion, d.ExportedAt.Format(time.RFC3339))
+
+	// With "fail" strategy nothing should be written if at least one
+	// conflict is present, so check everything first.
+	if conflict == conflictFail {
+		var conflicts int
+		for _, dp := range d.Packages {
+			if packages.GetPackageByOriginalURL(dp.OriginalPackageURL) != nil {
+				log.Error().Msgf("Package '%s' already exists", dp.OriginalPackageURL)
+				conflicts++
+			}
+		}
+
+		for _, du := range d.Users {
+			if users.GetUserByLogin(du.Login) != nil {
+				log.Error().Msgf("User '%s' already exists", du.Login)
+				conflicts++
+			}
+		}
+
+		if conflicts != 0 {
+			log.Fatal().Msgf("Found %d conflicts, import aborted, nothing was changed", conflicts)
+		}
+	}
+
+	var created, updated, skipped, failed int
+
+	for _, dp := range d.Packages {
+		res := importPackage(dp, conflict)
+		switch res {
+		case importCreated:
+			created++
+		case importUpdated:
+			updated++
+		case importSkipped:
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	switch importGlobalRoutingPolicies(d.RoutingPolicies, conflict) {
+	case importUpdated:
+		updated++
+	case importFailed:
+		failed++
+	}
+
+	for _, du := range d.Users {
+		res := importUser(du, conflict)
+		switch res {
+		case importCreated:
+			created++
+		case importUpdated:
+			updated++
+		case importSkipped:
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	log.Info().Msgf("Import finished: %d created, %d updated, %d skipped, %d failed", created, updated, skipped, failed)
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
+
+// Import results for single entry.
+const (
+	importFailed = iota
+	importCreated
+	importUpdated
+	importSkipped
+)
+
+// Imports single package. Package with all it's sources URLs and
+// routing policies is checked and written in single transaction.
+func importPackage(dp DumpPackage, conflict string) int {
+	pkg := packages.GetPackageByOriginalURL(dp.OriginalPackageURL)
+	result := importCreated
+
+	// Package's state before import, for audit log.
+	var before map[string]interface{}
+	if pkg != nil {
+		before = pkg.AuditData()
+	}
+
+	if dp.State != "" && !packages.IsValidState(dp.State) {
+		log.Error().Msgf("Package '%s' has unknown state '%s'", dp.OriginalPackageURL, dp.State)
+		return importFailed
+	}
+
+	if pkg != nil {
+		if conflict != conflictOverwrite {
+			log.Debug().Msgf("Package '%s' already exists, skipping", dp.OriginalPackageURL)
+			return importSkipped
+		}
+
+		pkg.Name = dp.Name
+		if dp.State != "" {
+			pkg.State = dp.State
+		}
+		pkg.StateReason = dp.StateReason
+
+		result = importUpdated
+	} else {
+		pkg = &packages.Package{
+			Name:               dp.Name,
+			OriginalPackageURL: dp.OriginalPackageURL,
+			State:              dp.State,
+			StateReason:        dp.StateReason,
+			CreatedAt:          dp.CreatedAt,
+		}
+
+		if pkg.CreatedAt.IsZero() {
+			pkg.CreatedAt = time.Now().UTC()
+		}
+	}
+
+	var urls []*packages.URL
+	for _, url := range dp.URLs {
+		urls = append(urls, &packages.URL{URL: url.URL, VCS: url.VCS, Mirror: url.Mirror, Enabled: url.Enabled})
+	}
+
+	var policies []*packages.RoutingPolicy
+	for _, drp := range dp.RoutingPolicies {
+		policies = append(policies, dumpedRoutingPolicy(drp))
+	}
+
+	if err := pkg.Replace(urls, policies, 0, "Imported by magisterctl"); err != nil {
+		log.Error().Msgf("Failed to import package '%s': %s", dp.OriginalPackageURL, err.Error())
+		return importFailed
+	}
+
+	event := &audit.Event{Action: audit.ActionPackageCreate, TargetType: audit.TargetPackage, TargetID: pkg.ID, Target: pkg.OriginalPackageURL}
+	if result == importCreated {
+		pkg.Emit(webhooks.EventPackageCreated)
+	} else {
+		event.Action = audit.ActionPackageUpdate
+		pkg.Emit(webhooks.EventPackageUpdated)
+	}
+	audit.Log(nil, event, before, pkg.AuditData())
+
+	return result
+}
+
+// Imports global routing policies. They have no natural key, so policy
+// is considered already existing if identical one is present.
+func importGlobalRoutingPolicies(dumped []DumpRoutingPolicy, conflict string) int {
+	if len(dumped) == 0 {
+		return importSkipped
+	}
+
+	existing := packages.GetRoutingPolicies(0)
+	if existing == nil {
+		return importFailed
+	}
+
+	if conflict == conflictOverwrite {
+		for _, rp := range existing {
+			if err := rp.Delete(); err != nil {
+				log.Error().Msgf("Failed to delete global routing policy #%d: %s", rp.ID, err.Error())
+				return importFailed
+			}
+		}
+		existing = nil
+	}
+
+	result := importSkipped
+	for _, drp := range dumped {
+		var found bool
+		for _, rp := range existing {
+			if rp.Priority == drp.Priority && rp.MatchType == drp.MatchType && rp.MatchValue == drp.MatchValue && rp.Mirror == drp.Mirror {
+				found = true
+				break
+			}
+		}
+
+		if found {
+			continue
+		}
+
+		if err := dumpedRoutingPolicy(drp).Create(); err != nil {
+			log.Error().Msgf("Failed to add global routing policy: %s", err.Error())
+			return importFailed
+		}
+		result = importUpdated
+	}
+
+	return result
+}
+
+// Returns routing policy from it's dump representation.
+func dumpedRoutingPolicy(drp DumpRoutingPolicy) *packages.RoutingPolicy {
+	return &packages.RoutingPolicy{
+		Priority:   drp.Priority,
+		MatchType:  drp.MatchType,
+		MatchValue: drp.MatchValue,
+		Mirror:     drp.Mirror,
+		Enabled:    drp.Enabled,
+	}
+}
+
+func importUser(du DumpUser, conflict string) int {
+	if du.Role != "" && !users.IsValidRole(du.Role) {
+		log.Error().Msgf("User '%s' has unknown role '%s'", du.Login, du.Role)
+		return importFailed
+	}
+
+	if du.AuthProvider != "" && !users.IsValidProvider(du.AuthProvider) {
+		log.Error().Msgf("User '%s' has unknown authentication provider '%s'", du.Login, du.AuthProvider)
+		return importFailed
+	}
+
+	u := users.GetUserByLogin(du.Login)
+
+	if u != nil {
+		if conflict != conflictOverwrite {
+			log.Debug().Msgf("User '%s' already exists, skipping", du.Login)
+			return importSkipped
+		}
+
+		role := u.Role
+		if du.Role != "" {
+			role = du.Role
+		}
+
+		// Same rule as in admin interface: import must not leave
+		// registry without active admin.
+		if u.IsActive && u.Role == users.RoleAdmin && (!du.IsActive || role != users.RoleAdmin) && users.CountActiveAdmins() <= 1 {
+			log.Error().Msgf("User '%s' is the last active admin, it can't be demoted or deactivated by import", du.Login)
+			return importFailed
+		}
+
+		before := u.AuditData()
+		u.Email = du.Email
+		u.IsActive = du.IsActive
+		u.Role = role
+		if du.AuthProvider != "" {
+			u.AuthProvider = du.AuthProvider
+		}
+		if du.OIDCSubject != "" {
+			u.OIDCIssuer = du.OIDCIssuer
+			u.OIDCSubject = du.OIDCSubject
+		}
+		// Keep current password if dump was made without them. PHC
+		// hashes have no separate salt.
+		if du.Password != "" {
+			u.Password = du.Password
+			u.PasswordSalt = du.PasswordSalt
+		}
+		u.Save()
+		audit.Log(nil, &audit.Event{Action: audit.ActionUserUpdate, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, before, u.AuditData())
+
+		return importUpdated
+	}
+
+	u = &users.User{
+		Login:        du.Login,
+		Email:        du.Email,
+		Role:         du.Role,
+		AuthProvider: du.AuthProvider,
+		OIDCIssuer:   du.OIDCIssuer,
+		OIDCSubject:  du.OIDCSubject,
+		Password:     du.Password,
+		PasswordSalt: du.PasswordSalt,
+		IsActive:     du.IsActive,
+		CreatedAt:    du.CreatedAt,
+		UpdatedAt:    time.Now().UTC(),
+	}
+
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = time.Now().UTC()
+	}
+
+	if err := u.Create(); err != nil {
+		log.Error().Msgf("Failed to create user '%s': %s", du.Login, err.Error())
+		return importFailed
+	}
+
+	audit.Log(nil, &audit.Event{Action: audit.ActionUserCreate, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, u.AuditData())
+
+	if u.Password == "" && u.IsLocal() {
+		log.Warn().Msgf("User '%s' was imported without password and will not be able to log in until password will be set", du.Login)
+	}
+
+	return importCreated
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Entry from other tool's configuration which wasn't mapped into
+// MAGISTER's package.
+type unmappedEntry struct {
+	// Where entry was found, e.g. "vanity.yaml: /portmidi".
+	Source string
+	// Why it wasn't mapped.
+	Reason string
+}
+
+// Result of parsing other tool's configuration.
+type foreignConfig struct {
+	Packages []DumpPackage
+	Unmapped []unmappedEntry
+	// Things that were mapped, but not completely (e.g. unsupported
+	// options were dropped).
+	Warnings []string
+}
+
+// Adds package to parsed configuration. Packages with same import path
+// and same sources URL are merged, and if sources URL differs - latter
+// will be reported as unmapped.
+func (fc *foreignConfig) addPackage(source string, importPath string, vcs string, repo string) {
+	importPath = strings.TrimSuffix(importPath, "/")
+
+	for _, p := range fc.Packages {
+		if p.OriginalPackageURL != importPath {
+			continue
+		}
+
+		if p.URLs[0].URL != repo || p.URLs[0].VCS != vcs {
+			fc.unmapped(source, "import path '"+importPath+"' is already mapped to "+p.URLs[0].VCS+" repository "+p.URLs[0].URL)
+		}
+
+		return
+	}
+
+	// Package name is an import path without host.
+	name := importPath
+	if idx := strings.Index(importPath, "/"); idx != -1 {
+		name = importPath[idx+1:]
+	}
+
+	fc.Packages = append(fc.Packages, DumpPackage{
+		Name:               name,
+		OriginalPackageURL: importPath,
+		CreatedAt:          time.Now().UTC(),
+		URLs:               []DumpPackageURL{{URL: repo, VCS: vcs, Enabled: true}},
+	})
+}
+
+func (fc *foreignConfig) unmapped(source string, reason string) {
+	fc.Unmapped = append(fc.Unmapped, unmappedEntry{Source: source, Reason: reason})
+}
+
+func (fc *foreignConfig) warn(format string, args ...interface{}) {
+	fc.Warnings = append(fc.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Imports packages from configuration of other vanity import paths
+// tools.
+func importFromOtherTool(args []string) {
+	var conflict string
+	var dryRun bool
+	var host string
+	var input string
+	var toolType string
+
+	fs := flag.NewFlagSet("import-from", flag.ExitOnError)
+	fs.StringVar(&toolType, "type", "", "Type of configuration to import: 'govanityurls' (vanity.yaml), 'html' (directory with static HTML pages containing go-import meta tags) or 'caddy' (Caddyfile with vanity directives).")
+	fs.StringVar(&input, "input", "", "Path to configuration file or directory.")
+	fs.StringVar(&host, "host", "", "Host to use for import paths if configuration doesn't specify it (e.g. 'go.example.com').")
+	fs.StringVar(&conflict, "conflict", conflictSkip, "What to do with packages which already exist: 'skip', 'overwrite' or 'fail'.")
+	fs.BoolVar(&dryRun, "dry_run", false, "Only show what would be imported, do not change anything.")
+	fs.Parse(args)
+
+	if input == "" {
+		log.Error().Msg("Configuration path wasn't provided")
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if conflict != conflictSkip && conflict != conflictOverwrite && conflict != conflictFail {
+		log.Fatal().Msgf("Unknown conflict strategy '%s'", conflict)
+	}
+
+	var fc *foreignConfig
+	var err error
+	switch toolType {
+	case "govanityurls":
+		fc, err = parseGovanityurlsConfig(input, host)
+	case "html":
+		fc, err = parseHTMLMetaTags(input)
+	case "caddy":
+		fc, err = parseCaddyfile(input, host)
+	default:
+		log.Error().Msgf("Unknown configuration type '%s'", toolType)
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		log.Fatal().Msgf("Failed to parse configuration: %s", err.Error())
+	}
+
+	var created, updated, skipped, failed int
+	if dryRun {
+		for _, dp := range fc.Packages {
+			fmt.Printf("%s -> %s %s\n", dp.OriginalPackageURL, dp.URLs[0].VCS, dp.URLs[0].URL)
+		}
+	} else {
+		if conflict == conflictFail {
+			var conflicts int
+			for _, dp := range fc.Packages {
+				if packages.GetPackageByOriginalURL(dp.OriginalPackageURL) != nil {
+					log.Error().Msgf("Package '%s' already exists", dp.OriginalPackageURL)
+					conflicts++
+				}
+			}
+
+			if conflicts != 0 {
+				log.Fatal().Msgf("Found %d conflicts, import aborted, nothing was changed", conflicts)
+			}
+		}
+
+		for _, dp := range fc.Packages {
+			switch importPackage(dp, conflict) {
+			case importCreated:
+				created++
+			case importUpdated:
+				updated++
+			case importSkipped:
+				skipped++
+			default:
+				failed++
+			}
+		}
+	}
+
+	// Report.
+	fmt.Printf("\nMapped %d packages", len(fc.Packages))
+	if !dryRun {
+		fmt.Printf(" (%d created, %d updated, %d skipped, %d failed)", created, updated, skipped, failed)
+	}
+	fmt.Printf("\n")
+
+	if len(fc.Warnings) != 0 {
+		fmt.Printf("\nWarnings:\n")
+		for _, w := range fc.Warnings {
+			fmt.Printf("  %s\n", w)
+		}
+	}
+
+	if len(fc.Unmapped) != 0 {
+		fmt.Printf("\nEntries which weren't mapped:\n")
+		for _, u := range fc.Unmapped {
+			fmt.Printf("  %s: %s\n", u.Source, u.Reason)
+		}
+	}
+
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
+
+// Normalizes host which might come with scheme, port or path.
+func normalizeHost(host string) string {
+	if idx := strings.Index(host, "://"); idx != -1 {
+		host = host[idx+3:]
+	}
+
+	if idx := strings.Index(host, "/"); idx != -1 {
+		host = host[:idx]
+	}
+
+	if idx := strings.LastIndex(host, ":"); idx != -1 {
+		host = host[:idx]
+	}
+
+	return host
+}
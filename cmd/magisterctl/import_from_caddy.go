@@ -0,0 +1,158 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+)
+
+// Parses Caddyfile and extracts vanity directives from it. Supported
+// directive syntax is:
+//
+//	vanity /path [vcs] repository-url
+//
+// VCS defaults to "git". Import path host is taken from site block
+// address in which directive is placed, or from passed host if
+// directive is placed outside of site block.
+func parseCaddyfile(path string, host string) (*foreignConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fc := &foreignConfig{}
+	host = normalizeHost(host)
+
+	// Stack of opened blocks. Only outermost block's address is used
+	// as host, nested blocks are matchers, routes, etc.
+	var blocks []string
+	// Outermost line which didn't open block: site address if next line
+	// is a lone "{".
+	var previous []string
+
+	for lineNumber, line := range strings.Split(string(data), "\n") {
+		source := path + ":" + strconv.Itoa(lineNumber+1)
+
+		if idx := strings.Index(line, "#"); idx != -1 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] == "}" {
+			if len(blocks) != 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			previous = nil
+			continue
+		}
+
+		opensBlock := fields[len(fields)-1] == "{"
+		if opensBlock {
+			fields = fields[:len(fields)-1]
+		}
+
+		// Lone "{" opens global options block at the beginning of
+		// Caddyfile, or site block which address is on previous line.
+		if len(fields) == 0 {
+			var address string
+			if len(blocks) == 0 && len(previous) != 0 {
+				address = normalizeHost(strings.TrimSuffix(previous[0], ","))
+			}
+			blocks = append(blocks, address)
+			previous = nil
+			continue
+		}
+
+		if len(blocks) == 0 && !opensBlock {
+			previous = fields
+		} else {
+			previous = nil
+		}
+
+		if fields[0] == "vanity" {
+			if opensBlock {
+				fc.unmapped(source, "block form of vanity directive is not supported")
+				blocks = append(blocks, "")
+				continue
+			}
+
+			siteHost := host
+			if len(blocks) != 0 && blocks[0] != "" {
+				siteHost = blocks[0]
+			}
+
+			parseCaddyVanityDirective(fc, source, siteHost, fields[1:])
+			continue
+		}
+
+		if opensBlock {
+			var address string
+			if len(blocks) == 0 && len(fields) != 0 {
+				// Site block can have multiple addresses, first one is
+				// used for import paths.
+				address = normalizeHost(strings.TrimSuffix(fields[0], ","))
+				if len(fields) > 1 {
+					fc.warn("%s: site block has multiple addresses, only '%s' is used for import paths", source, address)
+				}
+			}
+			blocks = append(blocks, address)
+		}
+	}
+
+	return fc, nil
+}
+
+// Parses arguments of single vanity directive.
+func parseCaddyVanityDirective(fc *foreignConfig, source string, host string, args []string) {
+	if host == "" {
+		fc.unmapped(source, "vanity directive is not inside site block, pass host with -host")
+		return
+	}
+
+	var importPath, vcs, repo string
+	switch len(args) {
+	case 2:
+		importPath, vcs, repo = args[0], "git", args[1]
+	case 3:
+		importPath, vcs, repo = args[0], args[1], args[2]
+	default:
+		fc.unmapped(source, "vanity directive should have 2 or 3 arguments, got "+strconv.Itoa(len(args)))
+		return
+	}
+
+	if !packages.IsKnownVCS(vcs) {
+		fc.unmapped(source, "unknown VCS '"+vcs+"'")
+		return
+	}
+
+	fc.addPackage(source, host+"/"+strings.Trim(importPath, "/"), vcs, repo)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Writes Caddyfile into temporary directory and parses it.
+func parseTestCaddyfile(t *testing.T, caddyfile string, host string) *foreignConfig {
+	dir, err := ioutil.TempDir("", "caddyfile")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "Caddyfile")
+	if err1 := ioutil.WriteFile(path, []byte(caddyfile), 0644); err1 != nil {
+		t.Fatalf("Failed to write Caddyfile: %s", err1.Error())
+	}
+
+	fc, err2 := parseCaddyfile(path, host)
+	if err2 != nil {
+		t.Fatalf("Failed to parse Caddyfile: %s", err2.Error())
+	}
+
+	return fc
+}
+
+func TestParseCaddyfileLoneBraces(t *testing.T) {
+	fc := parseTestCaddyfile(t, `{
+	email admin@example.com
+}
+
+go.example.com
+{
+	vanity /lib https://git.example.com/lib.git
+	route {
+		vanity /tool hg https://hg.example.com/tool
+	}
+}
+
+other.example.com {
+	vanity /app https://git.example.com/app.git
+}
+`, "")
+
+	expected := map[string]string{
+		"go.example.com/lib":    "https://git.example.com/lib.git",
+		"go.example.com/tool":   "https://hg.example.com/tool",
+		"other.example.com/app": "https://git.example.com/app.git",
+	}
+
+	if len(fc.Packages) != len(expected) {
+		t.Fatalf("Expected %d packages, got %d: %+v, unmapped: %+v", len(expected), len(fc.Packages), fc.Packages, fc.Unmapped)
+	}
+
+	for _, p := range fc.Packages {
+		if expected[p.OriginalPackageURL] != p.URLs[0].URL {
+			t.Errorf("Unexpected package '%s' with sources URL '%s'", p.OriginalPackageURL, p.URLs[0].URL)
+		}
+	}
+
+	if len(fc.Unmapped) != 0 {
+		t.Errorf("Expected nothing unmapped, got %+v", fc.Unmapped)
+	}
+}
+
+func TestParseCaddyfileGlobalOptionsWithoutSite(t *testing.T) {
+	fc := parseTestCaddyfile(t, "{\n}\nvanity /lib https://git.example.com/lib.git\n", "go.example.com")
+
+	if len(fc.Packages) != 1 || fc.Packages[0].OriginalPackageURL != "go.example.com/lib" {
+		t.Fatalf("Expected go.example.com/lib from passed host, got %+v, unmapped: %+v", fc.Packages, fc.Unmapped)
+	}
+}
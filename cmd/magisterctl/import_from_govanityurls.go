@@ -0,0 +1,125 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"errors"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"gopkg.in/yaml.v2"
+)
+
+// govanityurls's vanity.yaml structure.
+type govanityurlsConfig struct {
+	Host        string                              `yaml:"host"`
+	CacheMaxAge *int64                              `yaml:"cache_max_age"`
+	Paths       map[string]govanityurlsPathSettings `yaml:"paths"`
+}
+
+type govanityurlsPathSettings struct {
+	Repo    string `yaml:"repo"`
+	Display string `yaml:"display"`
+	VCS     string `yaml:"vcs"`
+}
+
+// Parses govanityurls's vanity.yaml. Host from configuration wins, and
+// passed host is used only if configuration does not have it.
+func parseGovanityurlsConfig(path string, host string) (*foreignConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &govanityurlsConfig{}
+	if err1 := yaml.Unmarshal(data, cfg); err1 != nil {
+		return nil, err1
+	}
+
+	if len(cfg.Paths) == 0 {
+		return nil, errors.New("no paths defined in configuration")
+	}
+
+	if cfg.Host != "" {
+		host = cfg.Host
+	}
+	host = normalizeHost(host)
+
+	fc := &foreignConfig{}
+
+	if cfg.CacheMaxAge != nil {
+		fc.warn("cache_max_age is not supported and was ignored")
+	}
+
+	// Maps are unordered, sort paths to get stable output.
+	paths := make([]string, 0, len(cfg.Paths))
+	for p := range cfg.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		settings := cfg.Paths[p]
+		source := path + ": " + p
+
+		if host == "" {
+			fc.unmapped(source, "host is not defined in configuration, pass it with -host")
+			continue
+		}
+
+		if settings.Repo == "" {
+			fc.unmapped(source, "repo is not defined")
+			continue
+		}
+
+		// Same as govanityurls does: VCS is required for everything
+		// except GitHub and Bitbucket.
+		vcs := settings.VCS
+		if vcs == "" {
+			if strings.HasPrefix(settings.Repo, "https://github.com/") || strings.HasPrefix(settings.Repo, "https://bitbucket.org/") {
+				vcs = "git"
+			} else {
+				fc.unmapped(source, "cannot infer VCS from "+settings.Repo+" and it wasn't specified")
+				continue
+			}
+		}
+
+		if !packages.IsKnownVCS(vcs) {
+			fc.unmapped(source, "unknown VCS '"+vcs+"'")
+			continue
+		}
+
+		if settings.Display != "" {
+			fc.warn("%s: display (go-source) template is not supported and was ignored", source)
+		}
+
+		fc.addPackage(source, host+"/"+strings.Trim(p, "/"), vcs, settings.Repo)
+	}
+
+	return fc, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"errors"
+	"html"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+)
+
+var (
+	htmlMetaTagRegexp   = regexp.MustCompile(`(?is)<meta\s+([^>]*)>`)
+	htmlAttributeRegexp = regexp.MustCompile(`(?is)([a-z][a-z0-9_-]*)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+// Parses directory with static HTML pages which contains go-import meta
+// tags, e.g. one generated for GitHub Pages or plain nginx.
+func parseHTMLMetaTags(dir string) (*foreignConfig, error) {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fi.IsDir() {
+		return nil, errors.New(dir + " is not a directory")
+	}
+
+	fc := &foreignConfig{}
+
+	err1 := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext != ".html" && ext != ".htm" {
+			return nil
+		}
+
+		data, err1 := ioutil.ReadFile(path)
+		if err1 != nil {
+			return err1
+		}
+
+		parseHTMLPage(fc, path, string(data))
+		return nil
+	})
+
+	if err1 != nil {
+		return nil, err1
+	}
+
+	return fc, nil
+}
+
+// Parses single HTML page.
+func parseHTMLPage(fc *foreignConfig, path string, data string) {
+	var found bool
+
+	for _, tag := range htmlMetaTagRegexp.FindAllStringSubmatch(data, -1) {
+		attrs := make(map[string]string)
+		for _, attr := range htmlAttributeRegexp.FindAllStringSubmatch(tag[1], -1) {
+			value := attr[2]
+			if value == "" {
+				value = attr[3]
+			}
+			attrs[strings.ToLower(attr[1])] = html.UnescapeString(value)
+		}
+
+		switch attrs["name"] {
+		case "go-import":
+		case "go-source":
+			fc.warn("%s: go-source meta tag is not supported and was ignored", path)
+			continue
+		default:
+			continue
+		}
+
+		found = true
+
+		// Content is "import-prefix vcs repo-root".
+		fields := strings.Fields(attrs["content"])
+		if len(fields) != 3 {
+			fc.unmapped(path, "malformed go-import meta tag content '"+attrs["content"]+"'")
+			continue
+		}
+
+		if fields[1] == "mod" {
+			fc.unmapped(path, "go-import for '"+fields[0]+"' points to module proxy, which isn't supported")
+			continue
+		}
+
+		if !packages.IsKnownVCS(fields[1]) {
+			fc.unmapped(path, "unknown VCS '"+fields[1]+"' for '"+fields[0]+"'")
+			continue
+		}
+
+		fc.addPackage(path, fields[0], fields[1], fields[2])
+	}
+
+	if !found {
+		fc.unmapped(path, "no go-import meta tag found")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	// stdlib
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	// local
+	"github.com/welltrainedfolks/magister/vulns"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Imports OSV advisories.
+func importVulnerabilities(args []string) {
+	var input string
+	var source string
+
+	fs := flag.NewFlagSet("import-vulns", flag.ExitOnError)
+	fs.StringVar(&input, "input", "", "Path to directory, zip or tar.gz archive or single JSON file with OSV advisories (e.g. offline copy of Go vulnerability database).")
+	fs.StringVar(&source, "source", "", "Where advisories came from, e.g. 'vulndb' or 'internal'. Defaults to input's file name.")
+	fs.Parse(args)
+
+	if input == "" {
+		log.Error().Msg("Advisories path wasn't provided")
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if source == "" {
+		source = filepath.Base(input)
+	}
+
+	report, err := vulns.Import(input, source)
+	if err != nil {
+		log.Fatal().Msgf("Failed to import advisories: %s", err.Error())
+	}
+
+	fmt.Printf("Imported advisories: %d created, %d updated, %d skipped (not modified), %d failed\n", report.Created, report.Updated, report.Skipped, len(report.Failed))
+
+	if len(report.Failed) != 0 {
+		fmt.Printf("\nAdvisories which weren't imported:\n")
+		for _, f := range report.Failed {
+			fmt.Printf("  %s\n", f)
+		}
+
+		os.Exit(1)
+	}
+}
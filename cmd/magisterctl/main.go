@@ -24,14 +24,18 @@ package main
 import (
 	// stdlib
 	"flag"
+	"fmt"
 	"os"
 
 	// local
+	"github.com/welltrainedfolks/magister/audit"
 	"github.com/welltrainedfolks/magister/common"
 	"github.com/welltrainedfolks/magister/internal/config"
 	"github.com/welltrainedfolks/magister/internal/database"
 	"github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/settings"
 	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
 	"github.com/welltrainedfolks/magister/users"
 
 	// other
@@ -46,22 +50,49 @@ var (
 	userEmail    string
 	userName     string
 	userPassword string
+	userRole     string
 
 	// Users registration.
 	actionUserDeletion     bool
 	actionUserRegistration bool
+	actionUserRoleChange   bool
+	actionUserUnlock       bool
+
+	// LDAP configuration check.
+	actionLDAPCheck bool
+
+	// Packages-related actions.
+	packageState       string
+	packageStateReason string
+	packageURL         string
+
+	// Packages state changing.
+	actionPackageStateChange bool
 )
 
 func main() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	log.Info().Msgf("Starting magisterctl, version %s (build %d, built on %s from revision %s, branch %s)", common.VERSION, common.BUILD, common.BUILDDATE, common.REVISION, common.BRANCH)
 
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [command [command flags]]\n\nCommands:\n  export\tExport registry (packages and users) as JSON or YAML.\n  import\tImport registry from previously exported dump.\n  import-from\tImport packages from govanityurls, static HTML pages or Caddyfile.\n  import-vulns\tImport vulnerabilities advisories in OSV format.\n\nUse \"%s -config path command -h\" to get command's flags.\n\nFlags:\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
+
 	// Initialize main CLI flags.
 	flag.StringVar(&userEmail, "user_email", "", "E-Mail address for user.")
 	flag.StringVar(&userName, "user_name", "", "User's name.")
 	flag.StringVar(&userPassword, "user_password", "", "User's password.")
 	flag.BoolVar(&actionUserDeletion, "user_delete", false, "Deletes user. Require \"user_name\" parameter.")
-	flag.BoolVar(&actionUserRegistration, "user_register", false, "Register user. Require all \"user_*\" variables.")
+	flag.StringVar(&userRole, "user_role", "", "User's role: admin, maintainer, auditor or viewer. Very first user registered with magisterctl is always admin.")
+	flag.BoolVar(&actionUserRegistration, "user_register", false, "Register user. Require \"user_name\", \"user_email\" and \"user_password\" parameters, \"user_role\" is optional.")
+	flag.BoolVar(&actionUserRoleChange, "user_set_role", false, "Changes user's role. Require \"user_name\" and \"user_role\" parameters.")
+	flag.BoolVar(&actionUserUnlock, "user_unlock", false, "Unlocks user which was locked after too many failed logins. Require \"user_name\" parameter.")
+	flag.BoolVar(&actionLDAPCheck, "ldap_check", false, "Checks LDAP configuration by authenticating user without creating it. Require \"user_name\" and \"user_password\" parameters.")
+	flag.StringVar(&packageURL, "package_url", "", "Package's original URL (as in import line).")
+	flag.StringVar(&packageState, "package_state", "", "Package's lifecycle state: draft, published, maintenance or archived.")
+	flag.StringVar(&packageStateReason, "package_state_reason", "", "Why package's state is changed.")
+	flag.BoolVar(&actionPackageStateChange, "package_set_state", false, "Changes package's state. Require \"package_url\" and \"package_state\" parameters, \"package_state_reason\" is optional.")
 
 	// Initialize everything that wants CLI flag(s).
 	config.Initialize()
@@ -73,6 +104,7 @@ func main() {
 	config.LoadConfiguration()
 	templater.Initialize()
 	database.Initialize()
+	settings.Initialize()
 	http.Initialize()
 
 	// Initialize modules.
@@ -82,6 +114,26 @@ func main() {
 		deleteUser()
 	} else if actionUserRegistration {
 		registerUser()
+	} else if actionUserRoleChange {
+		setUserRole()
+	} else if actionUserUnlock {
+		unlockUser()
+	} else if actionLDAPCheck {
+		checkLDAP()
+	} else if actionPackageStateChange {
+		setPackageState()
+	}
+
+	// Commands which have their own flags.
+	switch flag.Arg(0) {
+	case "export":
+		exportRegistry(flag.Args()[1:])
+	case "import":
+		importRegistry(flag.Args()[1:])
+	case "import-from":
+		importFromOtherTool(flag.Args()[1:])
+	case "import-vulns":
+		importVulnerabilities(flag.Args()[1:])
 	}
 }
 
@@ -93,17 +145,105 @@ func deleteUser() {
 
 	user := users.GetUserByLogin(userName)
 	if user == nil {
-		log.Fatal().Msgf("User '%s' wasn't found")
+		log.Fatal().Msgf("User '%s' wasn't found", userName)
 	}
 
 	err := user.Delete()
 	if err != nil {
 		log.Error().Msgf("Failed to delete user: %s", err.Error())
 	} else {
+		audit.Log(nil, &audit.Event{Action: audit.ActionUserDelete, TargetType: audit.TargetUser, TargetID: user.ID, Target: user.Login}, user.AuditData(), nil)
 		log.Info().Msg("User successfully deleted")
 	}
 }
 
+func setUserRole() {
+	if userName == "" || userRole == "" {
+		log.Error().Msg("User's login or role wasn't provided")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if !users.IsValidRole(userRole) {
+		log.Fatal().Msgf("Unknown role '%s'", userRole)
+	}
+
+	user := users.GetUserByLogin(userName)
+	if user == nil {
+		log.Fatal().Msgf("User '%s' wasn't found", userName)
+	}
+
+	if user.Role == users.RoleAdmin && userRole != users.RoleAdmin && user.IsActive && users.CountActiveAdmins() <= 1 {
+		log.Fatal().Msgf("User '%s' is the last active admin", userName)
+	}
+
+	before := user.AuditData()
+	user.Role = userRole
+	user.Save()
+	audit.Log(nil, &audit.Event{Action: audit.ActionUserUpdate, TargetType: audit.TargetUser, TargetID: user.ID, Target: user.Login}, before, user.AuditData())
+	log.Info().Msgf("User '%s' is now %s", userName, userRole)
+}
+
+func unlockUser() {
+	if userName == "" {
+		log.Error().Msg("User's login wasn't provided")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	user := users.GetUserByLogin(userName)
+	if user == nil {
+		log.Fatal().Msgf("User '%s' wasn't found", userName)
+	}
+
+	if !user.IsLocked() {
+		log.Info().Msgf("User '%s' isn't locked", userName)
+		return
+	}
+
+	before := user.AuditData()
+	user.Unlock()
+	audit.Log(nil, &audit.Event{Action: audit.ActionUserUnlock, TargetType: audit.TargetUser, TargetID: user.ID, Target: user.Login}, before, user.AuditData())
+	log.Info().Msgf("User '%s' unlocked", userName)
+}
+
+func checkLDAP() {
+	if userName == "" || userPassword == "" {
+		log.Error().Msg("User's login or password wasn't provided")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	identity, err := users.CheckLDAP(userName, userPassword)
+	if err != nil {
+		log.Fatal().Msgf("LDAP authentication failed: %s", err.Error())
+	}
+
+	log.Info().Msgf("LDAP authentication succeeded: login '%s', email '%s', role %s", identity.Login, identity.Email, identity.Role)
+}
+
+func setPackageState() {
+	if packageURL == "" || packageState == "" {
+		log.Error().Msg("Package's URL or state wasn't provided")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	pkg := packages.GetPackageByOriginalURL(packageURL)
+	if pkg == nil {
+		log.Fatal().Msgf("Package '%s' wasn't found", packageURL)
+	}
+
+	before := pkg.AuditData()
+	err := pkg.SetState(packageState, 0, packageStateReason)
+	if err != nil {
+		log.Error().Msgf("Failed to change package's state: %s", err.Error())
+	} else {
+		audit.Log(nil, &audit.Event{Action: audit.ActionPackageState, TargetType: audit.TargetPackage, TargetID: pkg.ID, Target: pkg.OriginalPackageURL}, before, pkg.AuditData())
+		log.Info().Msgf("Package '%s' is now %s", packageURL, packageState)
+	}
+}
+
 func registerUser() {
 	var failed bool
 
@@ -122,6 +262,11 @@ func registerUser() {
 		failed = true
 	}
 
+	if userRole != "" && !users.IsValidRole(userRole) {
+		log.Error().Msgf("Unknown role '%s'", userRole)
+		failed = true
+	}
+
 	if failed {
 		flag.PrintDefaults()
 	}
@@ -134,7 +279,20 @@ func registerUser() {
 		log.Fatal().Msgf("User with login '%s' already registered!", userName)
 	}
 
-	user := users.NewUser(userName, userEmail, userPassword)
+	// Very first user becomes admin, otherwise nobody would be able to
+	// manage MAGISTER. Only users registered here get it, so nobody can
+	// become admin by registering or logging in with LDAP or OpenID
+	// Connect before administrator does.
+	if users.CountUsers("") == 0 {
+		log.Info().Msgf("There are no users yet, '%s' will be admin", userName)
+		userRole = users.RoleAdmin
+	}
+
+	user := users.NewUser(userName, userEmail, userPassword, userRole)
+	if user == nil {
+		log.Fatal().Msgf("Failed to register user '%s'", userName)
+	}
 	user.SetActive()
+	audit.Log(nil, &audit.Event{Action: audit.ActionUserCreate, TargetType: audit.TargetUser, TargetID: user.ID, Target: user.Login}, nil, user.AuditData())
 	log.Info().Msgf("Registered new user: %+v", user)
 }
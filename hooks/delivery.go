@@ -0,0 +1,161 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package hooks
+
+import (
+	// stdlib
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Deliveries statuses.
+const (
+	// Delivery matched packages and was processed.
+	StatusProcessed = "processed"
+	// Delivery was valid, but no package uses it's repository.
+	StatusUnmatched = "unmatched"
+	// Event isn't a push or tag event (e.g. "ping").
+	StatusIgnored = "ignored"
+	// Signature or token is invalid or provider is disabled.
+	StatusRejected = "rejected"
+	// Payload can't be parsed.
+	StatusFailed = "failed"
+)
+
+// Delivery is a logged inbound webhook request.
+type Delivery struct {
+	ID            int       `db:"id"`
+	Provider      string    `db:"provider"`
+	Event         string    `db:"event"`
+	DeliveryID    string    `db:"delivery_id"`
+	RepositoryURL string    `db:"repository_url"`
+	Ref           string    `db:"ref"`
+	Status        string    `db:"status"`
+	Message       string    `db:"message"`
+	Packages      string    `db:"packages"`
+	Headers       string    `db:"headers"`
+	Payload       string    `db:"payload"`
+	RemoteAddr    string    `db:"remote_addr"`
+	ReplayOf      int       `db:"replay_of"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// GetDeliveries returns latest deliveries, newest first.
+func GetDeliveries(limit int) []*Delivery {
+	deliveries := []*Delivery{}
+	err := database.DB.Select(&deliveries, database.DB.Rebind("SELECT * FROM `hooks_deliveries` ORDER BY id DESC LIMIT ?"), limit)
+	if err != nil {
+		log.Error().Msgf("Failed to get hooks deliveries: %s", err.Error())
+		return nil
+	}
+
+	return deliveries
+}
+
+// CountDeliveriesSince returns count of deliveries received since
+// passed time for every status.
+func CountDeliveriesSince(since time.Time) map[string]int {
+	rows := []struct {
+		Status string `db:"status"`
+		Count  int    `db:"count"`
+	}{}
+	err := database.DB.Select(&rows, database.DB.Rebind("SELECT status, COUNT(*) AS count FROM `hooks_deliveries` WHERE created_at>=? GROUP BY status"), since)
+	if err != nil {
+		log.Error().Msgf("Failed to count hooks deliveries: %s", err.Error())
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts
+}
+
+// GetDeliveryByID returns delivery by it's ID.
+func GetDeliveryByID(id int) *Delivery {
+	delivery := &Delivery{}
+	err := database.DB.Get(delivery, database.DB.Rebind("SELECT * FROM `hooks_deliveries` WHERE id=?"), id)
+	if err != nil {
+		log.Error().Msgf("Failed to get hook delivery with id '%d': %s", id, err.Error())
+		return nil
+	}
+
+	return delivery
+}
+
+// Create inserts delivery into database.
+func (d *Delivery) Create() error {
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now().UTC()
+	}
+
+	// Columns are limited, payload is kept anyway.
+	if len(d.Message) > 1024 {
+		d.Message = d.Message[:1021] + "..."
+	}
+	if len(d.Packages) > 4096 {
+		d.Packages = d.Packages[:4093] + "..."
+	}
+	if len(d.RepositoryURL) > 1024 {
+		d.RepositoryURL = d.RepositoryURL[:1024]
+	}
+	if len(d.Ref) > 255 {
+		d.Ref = d.Ref[:255]
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `hooks_deliveries` (provider, event, delivery_id, repository_url, ref, status, message, packages, headers, payload, remote_addr, replay_of, created_at) VALUES (:provider, :event, :delivery_id, :repository_url, :ref, :status, :message, :packages, :headers, :payload, :remote_addr, :replay_of, :created_at)", d)
+	if err != nil {
+		return err
+	}
+
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+
+	d.ID = int(lastInsertedID)
+	return nil
+}
+
+// DeleteDeliveriesBefore deletes deliveries older than passed time and
+// returns how many were deleted.
+func DeleteDeliveriesBefore(before time.Time) (int64, error) {
+	res, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `hooks_deliveries` WHERE created_at<?"), before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// IsReplayable returns true if delivery might be processed again.
+// Rejected deliveries weren't verified and can't be replayed.
+func (d *Delivery) IsReplayable() bool {
+	return d.Status != StatusRejected
+}
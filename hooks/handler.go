@@ -0,0 +1,135 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package hooks
+
+import (
+	// stdlib
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Maximum payload size which is accepted.
+const maxPayloadSize = 5 * 1024 * 1024
+
+// Limits rejected deliveries per client's IP address. Deliveries over
+// limit aren't logged, so hooks endpoint can't be used to fill database.
+var rejectedLimiter = helpers.NewRateLimiter(30, 10*time.Minute)
+
+// Response to git host.
+type hookResponse struct {
+	Delivery int      `json:"delivery"`
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+	Packages []string `json:"packages"`
+}
+
+// Receives push webhooks.
+func hookPOST(ec echo.Context) error {
+	providerName := ec.Param("provider")
+	p := providers[providerName]
+	if p == nil || !settings.Bool("features.inbound_hooks") {
+		return h.NotFoundGET(ec)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(ec.Request().Body, maxPayloadSize+1))
+	if err != nil {
+		log.Error().Msgf("Failed to read %s hook payload: %s", providerName, err.Error())
+		return ec.NoContent(http.StatusBadRequest)
+	}
+
+	if len(body) > maxPayloadSize {
+		return ec.JSON(http.StatusRequestEntityTooLarge, &hookResponse{Status: StatusRejected, Message: "Payload is too large"})
+	}
+
+	headers := ec.Request().Header
+	event, deliveryID := p.event(headers)
+
+	d := &Delivery{
+		Provider:   providerName,
+		Event:      event,
+		DeliveryID: deliveryID,
+		RemoteAddr: h.ClientIP(ec.Request()).String(),
+	}
+
+	status := http.StatusOK
+	secret := p.secret()
+	switch {
+	case secret == "":
+		d.Status = StatusRejected
+		d.Message = "Provider is disabled, set it's secret in configuration"
+		status = http.StatusForbidden
+	case !p.verify(headers, body, secret):
+		d.Status = StatusRejected
+		d.Message = "Invalid signature or token"
+		status = http.StatusUnauthorized
+	default:
+		d.Headers = encodeHeaders(headers)
+		d.Payload = string(body)
+		process(d, p, headers, body)
+		if d.Status == StatusFailed {
+			status = http.StatusBadRequest
+		}
+	}
+
+	// Payload of rejected delivery isn't verified and can't be replayed,
+	// so only it's size and few headers are kept.
+	if d.Status == StatusRejected {
+		if !rejectedLimiter.Allow(d.RemoteAddr) {
+			log.Debug().Msgf("Too many rejected %s hooks from %s, delivery isn't logged", providerName, d.RemoteAddr)
+			return ec.JSON(http.StatusTooManyRequests, &hookResponse{Status: StatusRejected, Message: "Too many rejected deliveries", Packages: []string{}})
+		}
+
+		d.Headers = encodeRejectedHeaders(headers)
+		d.Message += " (payload of " + strconv.Itoa(len(body)) + " bytes isn't kept)"
+	}
+
+	log.Info().Msgf("Received %s hook '%s' (delivery '%s'): %s, %s", providerName, event, deliveryID, d.Status, d.Message)
+
+	if err1 := d.Create(); err1 != nil {
+		log.Error().Msgf("Failed to log hook delivery: %s", err1.Error())
+	}
+
+	resp := &hookResponse{
+		Delivery: d.ID,
+		Status:   d.Status,
+		Message:  d.Message,
+		Packages: []string{},
+	}
+	if d.Packages != "" {
+		resp.Packages = strings.Split(d.Packages, ",")
+	}
+
+	return ec.JSON(status, resp)
+}
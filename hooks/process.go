@@ -0,0 +1,212 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package hooks
+
+import (
+	// stdlib
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/proxy"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Headers which carry secrets and must not be logged.
+var secretHeaders = []string{"Authorization", "Cookie", "X-Gitlab-Token"}
+
+// Headers which are logged for rejected deliveries, enough to tell who
+// sent it. Anyone might send rejected delivery, so nothing else is kept.
+var rejectedHeaders = []string{
+	"Content-Type", "User-Agent",
+	"X-GitHub-Event", "X-GitHub-Delivery",
+	"X-Gitlab-Event", "X-Gitlab-Event-UUID",
+	"X-Gitea-Event", "X-Gitea-Delivery", "X-Forgejo-Event", "X-Forgejo-Delivery",
+	"X-Event-Key", "X-Request-UUID",
+}
+
+// Processes verified delivery: finds packages which use pushed
+// repository, notifies outgoing webhooks and compares packages' module
+// proxy snapshots with upstream, so new versions are snapshotted
+// without waiting for next check. Documentation isn't refreshed,
+// MAGISTER doesn't render it. Delivery's status, message and matched
+// packages are filled in.
+func process(d *Delivery, p *provider, headers http.Header, body []byte) {
+	if !p.isPush(d.Event) {
+		d.Status = StatusIgnored
+		d.Message = "Event '" + d.Event + "' isn't a push or tag event"
+		return
+	}
+
+	event, err := p.parse(body)
+	if err != nil {
+		d.Status = StatusFailed
+		d.Message = "Failed to parse payload: " + err.Error()
+		return
+	}
+
+	d.Ref = event.Ref
+
+	var matched []*packages.Package
+	seen := make(map[int]bool)
+	for _, url := range event.RepositoryURLs {
+		if url == "" {
+			continue
+		}
+
+		if d.RepositoryURL == "" {
+			d.RepositoryURL = url
+		}
+
+		for _, pkg := range packages.FindPackagesByRepositoryURL(url) {
+			if !seen[pkg.ID] {
+				seen[pkg.ID] = true
+				matched = append(matched, pkg)
+			}
+		}
+	}
+
+	if len(matched) == 0 {
+		d.Status = StatusUnmatched
+		d.Message = "No package uses this repository"
+		return
+	}
+
+	var importPaths []string
+	var modules int
+	for _, pkg := range matched {
+		importPaths = append(importPaths, pkg.OriginalPackageURL)
+		log.Info().Msgf("Package '%s' was pushed to (%s, %s)", pkg.OriginalPackageURL, d.Provider, d.Ref)
+		pkg.EmitPush(d.Provider, d.Ref)
+		modules += refreshSnapshots(pkg)
+	}
+
+	d.Status = StatusProcessed
+	d.Packages = strings.Join(importPaths, ",")
+	d.Message = "Matched " + strconv.Itoa(len(matched)) + " package(s)"
+	if modules != 0 {
+		d.Message += ", " + strconv.Itoa(modules) + " snapshotted module(s) queued for comparison with upstream"
+	}
+}
+
+// Queues package's snapshotted modules for comparison with upstream.
+// New versions are snapshotted and vanished ones are flagged, which
+// emits package.version_added and package.version_discrepancy events.
+// Modules which are already queued aren't queued again. Returns how many
+// modules were queued.
+func refreshSnapshots(pkg *packages.Package) int {
+	if !proxy.Enabled() {
+		return 0
+	}
+
+	var queued int
+	for _, module := range proxy.PackageModules(pkg) {
+		if proxy.QueueCheck(module) {
+			queued++
+		}
+	}
+
+	return queued
+}
+
+// Returns headers as JSON without secrets.
+func encodeHeaders(headers http.Header) string {
+	safe := make(http.Header)
+	for name, values := range headers {
+		safe[name] = values
+	}
+
+	for _, name := range secretHeaders {
+		safe.Del(name)
+	}
+
+	data, err := json.Marshal(safe)
+	if err != nil {
+		log.Error().Msgf("Failed to encode hook delivery headers: %s", err.Error())
+		return "{}"
+	}
+
+	return string(data)
+}
+
+// Returns only headers which are logged for rejected deliveries, as
+// JSON.
+func encodeRejectedHeaders(headers http.Header) string {
+	kept := make(http.Header)
+	for _, name := range rejectedHeaders {
+		if value := headers.Get(name); value != "" {
+			// Values are limited, header might be arbitrary long.
+			if len(value) > 256 {
+				value = value[:256]
+			}
+			kept.Set(name, value)
+		}
+	}
+
+	return encodeHeaders(kept)
+}
+
+// Replay processes logged delivery again and logs result as new
+// delivery.
+func Replay(id int) (*Delivery, error) {
+	original := GetDeliveryByID(id)
+	if original == nil {
+		return nil, errors.New("delivery wasn't found")
+	}
+
+	if !original.IsReplayable() {
+		return nil, errors.New("rejected deliveries can't be replayed")
+	}
+
+	p := providers[original.Provider]
+	if p == nil {
+		return nil, errors.New("unknown provider '" + original.Provider + "'")
+	}
+
+	headers := make(http.Header)
+	if err := json.Unmarshal([]byte(original.Headers), &headers); err != nil {
+		return nil, err
+	}
+
+	d := &Delivery{
+		Provider:   original.Provider,
+		Event:      original.Event,
+		DeliveryID: original.DeliveryID,
+		Headers:    original.Headers,
+		Payload:    original.Payload,
+		ReplayOf:   original.ID,
+	}
+
+	process(d, p, headers, []byte(original.Payload))
+
+	if err := d.Create(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
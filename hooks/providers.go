@@ -0,0 +1,265 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package hooks
+
+import (
+	// stdlib
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+)
+
+// Push or tag event extracted from payload.
+type pushEvent struct {
+	// Repository URLs from payload, any of them might be used in
+	// packages sources URLs.
+	RepositoryURLs []string
+	// Pushed ref, e.g. "refs/heads/master" or "refs/tags/v1.0.0".
+	Ref string
+}
+
+// Git host which sends webhooks.
+type provider struct {
+	// Returns secret from configuration.
+	secret func() string
+	// Verifies request's signature or token.
+	verify func(headers http.Header, body []byte, secret string) bool
+	// Returns event name and delivery ID from headers.
+	event func(headers http.Header) (string, string)
+	// Returns true if event is a push or tag event.
+	isPush func(event string) bool
+	// Parses push or tag event's payload.
+	parse func(body []byte) (*pushEvent, error)
+}
+
+var providers = map[string]*provider{
+	"github": {
+		secret: func() string { return config.Config.Hooks.GitHub.Secret },
+		verify: func(headers http.Header, body []byte, secret string) bool {
+			return verifyHMAC(headers.Get("X-Hub-Signature-256"), "sha256=", body, secret)
+		},
+		event: func(headers http.Header) (string, string) {
+			return headers.Get("X-GitHub-Event"), headers.Get("X-GitHub-Delivery")
+		},
+		isPush: func(event string) bool { return event == "push" || event == "create" },
+		parse:  parseGitHubPayload,
+	},
+	"gitlab": {
+		secret: func() string { return config.Config.Hooks.GitLab.Secret },
+		verify: func(headers http.Header, body []byte, secret string) bool {
+			return subtle.ConstantTimeCompare([]byte(headers.Get("X-Gitlab-Token")), []byte(secret)) == 1
+		},
+		event: func(headers http.Header) (string, string) {
+			return headers.Get("X-Gitlab-Event"), headers.Get("X-Gitlab-Event-UUID")
+		},
+		isPush: func(event string) bool { return event == "Push Hook" || event == "Tag Push Hook" },
+		parse:  parseGitLabPayload,
+	},
+	// Forgejo is a Gitea fork and sends same payloads, with both its
+	// own and Gitea's headers.
+	"gitea": {
+		secret: func() string { return config.Config.Hooks.Gitea.Secret },
+		verify: verifyGiteaSignature,
+		event:  giteaEvent,
+		isPush: func(event string) bool { return event == "push" || event == "create" },
+		parse:  parseGitHubPayload,
+	},
+	"forgejo": {
+		secret: func() string { return config.Config.Hooks.Gitea.Secret },
+		verify: verifyGiteaSignature,
+		event:  giteaEvent,
+		isPush: func(event string) bool { return event == "push" || event == "create" },
+		parse:  parseGitHubPayload,
+	},
+	// Both Bitbucket Cloud and Bitbucket Server (Data Center).
+	"bitbucket": {
+		secret: func() string { return config.Config.Hooks.Bitbucket.Secret },
+		verify: func(headers http.Header, body []byte, secret string) bool {
+			return verifyHMAC(headers.Get("X-Hub-Signature"), "sha256=", body, secret)
+		},
+		event: func(headers http.Header) (string, string) {
+			deliveryID := headers.Get("X-Request-UUID")
+			if deliveryID == "" {
+				deliveryID = headers.Get("X-Request-Id")
+			}
+			return headers.Get("X-Event-Key"), deliveryID
+		},
+		isPush: func(event string) bool { return event == "repo:push" || event == "repo:refs_changed" },
+		parse:  parseBitbucketPayload,
+	},
+}
+
+// Checks hex-encoded HMAC-SHA256 signature of body.
+func verifyHMAC(signature string, prefix string, body []byte, secret string) bool {
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+
+	received, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+func verifyGiteaSignature(headers http.Header, body []byte, secret string) bool {
+	signature := headers.Get("X-Forgejo-Signature")
+	if signature == "" {
+		signature = headers.Get("X-Gitea-Signature")
+	}
+
+	return verifyHMAC(signature, "", body, secret)
+}
+
+func giteaEvent(headers http.Header) (string, string) {
+	if event := headers.Get("X-Forgejo-Event"); event != "" {
+		return event, headers.Get("X-Forgejo-Delivery")
+	}
+
+	return headers.Get("X-Gitea-Event"), headers.Get("X-Gitea-Delivery")
+}
+
+// GitHub's and Gitea's push and create events payloads.
+type githubPayload struct {
+	Ref string `json:"ref"`
+	// Only in create event, "branch" or "tag".
+	RefType    string `json:"ref_type"`
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		GitURL   string `json:"git_url"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"repository"`
+}
+
+func parseGitHubPayload(body []byte) (*pushEvent, error) {
+	payload := &githubPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, err
+	}
+
+	ref := payload.Ref
+	switch payload.RefType {
+	case "tag":
+		ref = "refs/tags/" + ref
+	case "branch":
+		ref = "refs/heads/" + ref
+	}
+
+	return &pushEvent{
+		RepositoryURLs: []string{payload.Repository.CloneURL, payload.Repository.SSHURL, payload.Repository.GitURL, payload.Repository.HTMLURL},
+		Ref:            ref,
+	}, nil
+}
+
+// GitLab's push and tag push events payloads.
+type gitlabPayload struct {
+	Ref     string `json:"ref"`
+	Project struct {
+		GitHTTPURL string `json:"git_http_url"`
+		GitSSHURL  string `json:"git_ssh_url"`
+		WebURL     string `json:"web_url"`
+	} `json:"project"`
+}
+
+func parseGitLabPayload(body []byte) (*pushEvent, error) {
+	payload := &gitlabPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, err
+	}
+
+	return &pushEvent{
+		RepositoryURLs: []string{payload.Project.GitHTTPURL, payload.Project.GitSSHURL, payload.Project.WebURL},
+		Ref:            payload.Ref,
+	}, nil
+}
+
+// Bitbucket Cloud's "repo:push" and Bitbucket Server's
+// "repo:refs_changed" payloads.
+type bitbucketPayload struct {
+	Repository struct {
+		Links struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			// Bitbucket Server.
+			Clone []struct {
+				Href string `json:"href"`
+			} `json:"clone"`
+		} `json:"links"`
+	} `json:"repository"`
+	// Bitbucket Cloud.
+	Push struct {
+		Changes []struct {
+			New *struct {
+				Name string `json:"name"`
+				Type string `json:"type"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+	// Bitbucket Server.
+	Changes []struct {
+		RefID string `json:"refId"`
+	} `json:"changes"`
+}
+
+func parseBitbucketPayload(body []byte) (*pushEvent, error) {
+	payload := &bitbucketPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, err
+	}
+
+	event := &pushEvent{RepositoryURLs: []string{payload.Repository.Links.HTML.Href}}
+	for _, clone := range payload.Repository.Links.Clone {
+		event.RepositoryURLs = append(event.RepositoryURLs, clone.Href)
+	}
+
+	for _, change := range payload.Push.Changes {
+		if change.New == nil {
+			continue
+		}
+
+		if change.New.Type == "tag" {
+			event.Ref = "refs/tags/" + change.New.Name
+		} else {
+			event.Ref = "refs/heads/" + change.New.Name
+		}
+		break
+	}
+
+	if event.Ref == "" && len(payload.Changes) != 0 {
+		event.Ref = payload.Changes[0].RefID
+	}
+
+	return event, nil
+}
@@ -28,4 +28,8 @@ type HTTP struct {
 	Port                string `yaml:"port"`
 	Domain              string `yaml:"domain"`
 	SessionValidityDays int    `yaml:"session_validity_days"`
+	SessionIdleHours    int    `yaml:"session_idle_hours"`
+	// Addresses or CIDRs of reverse proxies which are allowed to pass
+	// client's address in X-Forwarded-For and X-Real-IP headers.
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
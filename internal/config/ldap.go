@@ -0,0 +1,50 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+type LDAP struct {
+	// Authenticate users against LDAP or Active Directory. Disabled if
+	// not set.
+	Enabled *bool `yaml:"enabled"`
+	// Server URL, e.g. "ldap://ldap.example.com:389" or
+	// "ldaps://dc.example.com".
+	URL string `yaml:"url"`
+	// Upgrade ldap:// connection with StartTLS.
+	StartTLS *bool `yaml:"start_tls"`
+	// Account which is used to search for users. Anonymous search is
+	// used if empty.
+	BindDN       string `yaml:"bind_dn"`
+	BindPassword string `yaml:"bind_password"`
+	// Where users are searched.
+	BaseDN string `yaml:"base_dn"`
+	// Filter for user search, "%s" is replaced with login.
+	UserFilter string `yaml:"user_filter"`
+	// Attributes with user's login, email and groups.
+	LoginAttribute string `yaml:"login_attribute"`
+	EmailAttribute string `yaml:"email_attribute"`
+	GroupAttribute string `yaml:"group_attribute"`
+	// Group to role mapping as "group:role".
+	RoleMapping []string `yaml:"role_mapping"`
+	// Role for users which aren't in any mapped group. Such users
+	// can't log in if empty.
+	DefaultRole string `yaml:"default_role"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+type OIDC struct {
+	// Allow logging in with OpenID Connect identity provider. Disabled
+	// if not set.
+	Enabled *bool `yaml:"enabled"`
+	// Issuer URL, discovery document is requested from
+	// "/.well-known/openid-configuration" under it.
+	Issuer       string `yaml:"issuer"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	// Requested scopes, "openid" is required.
+	Scopes []string `yaml:"scopes"`
+	// Shown on login button as "Sign in with ...".
+	ButtonLabel string `yaml:"button_label"`
+	// Claims with user's login, email and groups.
+	LoginClaim  string `yaml:"login_claim"`
+	EmailClaim  string `yaml:"email_claim"`
+	GroupsClaim string `yaml:"groups_claim"`
+	// Group to role mapping as "group:role".
+	RoleMapping []string `yaml:"role_mapping"`
+	// Role for users which aren't in any mapped group. Such users
+	// can't log in if empty.
+	DefaultRole string `yaml:"default_role"`
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package config
+
+type Proxy struct {
+	// Directory where versions snapshots and go tool's cache are
+	// stored. Module proxy can't be enabled if it's empty.
+	StoragePath string `yaml:"storage_path"`
+	// Go binary which fetches versions, "go" from PATH if empty.
+	GoBinary string `yaml:"go_binary"`
+	// GOPROXY value for fetching versions, "direct" if empty. Direct
+	// fetch asks MAGISTER itself where package's sources are.
+	Upstream string `yaml:"upstream"`
+	// How many days unpinned snapshots which weren't downloaded are
+	// kept. Kept forever if not set.
+	RetentionDays int `yaml:"retention_days"`
+	// How often snapshotted versions are compared with upstream.
+	CheckHours int `yaml:"check_hours"`
+}
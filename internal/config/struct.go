@@ -22,8 +22,18 @@
 package config
 
 type Configuration struct {
-	HTTP       HTTP       `yaml:"http"`
-	Database   Database   `yaml:"database"`
-	MailSender MailSender `yaml:"mailsender"`
-	Site       Site       `yaml:"site"`
+	Audit         Audit         `yaml:"audit"`
+	HTTP          HTTP          `yaml:"http"`
+	Database      Database      `yaml:"database"`
+	Features      Features      `yaml:"features"`
+	Hooks         Hooks         `yaml:"hooks"`
+	LDAP          LDAP          `yaml:"ldap"`
+	MailSender    MailSender    `yaml:"mailsender"`
+	OIDC          OIDC          `yaml:"oidc"`
+	Packages      Packages      `yaml:"packages"`
+	PasswordReset PasswordReset `yaml:"password_reset"`
+	Proxy         Proxy         `yaml:"proxy"`
+	Registration  Registration  `yaml:"registration"`
+	Security      Security      `yaml:"security"`
+	Site          Site          `yaml:"site"`
 }
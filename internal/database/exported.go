@@ -24,6 +24,7 @@ package database
 import (
 	// stdlib
 	"fmt"
+	"strings"
 
 	// local
 	"github.com/welltrainedfolks/magister/internal/config"
@@ -68,3 +69,20 @@ func Initialize() {
 	// Migrate database.
 	migrations.Process(DB.DB)
 }
+
+// Check checks database connection and returns version database is
+// migrated to.
+func Check() (int64, error) {
+	if err := DB.Ping(); err != nil {
+		return 0, err
+	}
+
+	return migrations.Version(DB.DB)
+}
+
+// EscapeLike escapes LIKE wildcards in user's input.
+func EscapeLike(s string) string {
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	s = strings.Replace(s, "%", "\\%", -1)
+	return strings.Replace(s, "_", "\\_", -1)
+}
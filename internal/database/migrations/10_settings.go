@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func SettingsUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `settings` (`key` varchar(191) NOT NULL COMMENT 'Setting key, e.g. site.name', `value` text NOT NULL COMMENT 'Setting value', `updated_by` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of user who changed setting last time', `updated_at` datetime NOT NULL COMMENT 'When setting was changed last time', PRIMARY KEY (`key`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Settings overridden thru web interface'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func SettingsDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `settings`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
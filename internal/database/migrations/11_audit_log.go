@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func AuditLogUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `audit_log` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Event ID', `actor_id` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of user who made action, 0 for anonymous or magisterctl', `actor_login` varchar(255) NOT NULL DEFAULT '' COMMENT 'Login of user who made action at the moment of action', `ip` varchar(45) NOT NULL DEFAULT '' COMMENT 'Client IP address', `action` varchar(64) NOT NULL COMMENT 'Action, e.g. package.update', `target_type` varchar(32) NOT NULL DEFAULT '' COMMENT 'Type of object action was made on', `target_id` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of object action was made on', `target` varchar(255) NOT NULL DEFAULT '' COMMENT 'Human-readable object name', `before` mediumtext NOT NULL COMMENT 'JSON with changed fields values before action', `after` mediumtext NOT NULL COMMENT 'JSON with changed fields values after action', `created_at` datetime NOT NULL COMMENT 'When action was made', PRIMARY KEY (`id`), KEY `created_at` (`created_at`), KEY `action` (`action`), KEY `actor_login` (`actor_login`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Audit log of administrative and security events'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func AuditLogDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `audit_log`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func UsersRolesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` ADD `role` varchar(32) NOT NULL DEFAULT 'viewer' COMMENT 'What user can do in admin interface' AFTER `email`;"); err != nil {
+		return err
+	}
+
+	// Before roles every user could do everything, keep it this way.
+	if _, err := tx.Exec("UPDATE `users` SET `role`='admin';"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UsersRolesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` DROP COLUMN `role`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func UsersTwoFactorUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` ADD `totp_secret` varchar(64) NOT NULL DEFAULT '' COMMENT 'TOTP secret, empty if two-factor authentication was never set up' AFTER `password_salt`, ADD `totp_enabled` boolean NOT NULL DEFAULT false COMMENT 'Is two-factor authentication enabled?' AFTER `totp_secret`, ADD `totp_last_step` bigint NOT NULL DEFAULT 0 COMMENT 'TOTP step of last accepted code, codes can not be reused' AFTER `totp_enabled`;"); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("CREATE TABLE `users_recovery_codes` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Code ID', `user_id` int(11) NOT NULL COMMENT 'User ID', `code_hash` varchar(64) NOT NULL COMMENT 'SHA-256 of code', `created_at` datetime NOT NULL COMMENT 'When code was generated', PRIMARY KEY (`id`), KEY `user_id` (`user_id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Two-factor authentication recovery codes, used codes are deleted'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UsersTwoFactorDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `users_recovery_codes`;"); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("ALTER TABLE `users` DROP COLUMN `totp_secret`, DROP COLUMN `totp_enabled`, DROP COLUMN `totp_last_step`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func UsersAccessTokensUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `users_access_tokens` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Token ID', `user_id` int(11) NOT NULL COMMENT 'User ID', `name` varchar(128) NOT NULL COMMENT 'Name given by user', `token_hash` varchar(64) NOT NULL COMMENT 'SHA-256 of token', `scopes` varchar(255) NOT NULL COMMENT 'Comma-separated scopes', `expires_at` datetime DEFAULT NULL COMMENT 'When token expires, NULL if never', `last_used_at` datetime DEFAULT NULL COMMENT 'When token was used last time', `created_at` datetime NOT NULL COMMENT 'When token was created', PRIMARY KEY (`id`), UNIQUE KEY `token_hash` (`token_hash`), KEY `user_id` (`user_id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Personal access tokens for API, CLI and go tool'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UsersAccessTokensDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `users_access_tokens`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
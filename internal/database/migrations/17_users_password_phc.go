@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func UsersPasswordPHCUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` MODIFY `password` varchar(255) NOT NULL COMMENT 'Password hash in PHC string format, or legacy hex-encoded hash', MODIFY `password_salt` varchar(64) NOT NULL DEFAULT '' COMMENT 'Salt of legacy password hash, PHC hashes keep salt inside';"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UsersPasswordPHCDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` MODIFY `password` varchar(64) NOT NULL COMMENT 'User password', MODIFY `password_salt` varchar(64) NOT NULL COMMENT 'Password salt';"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func UsersLockoutUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` ADD `failed_logins` int(11) NOT NULL DEFAULT 0 COMMENT 'Failed logins in a row' AFTER `last_login_at`, ADD `last_failed_login_at` datetime DEFAULT NULL COMMENT 'When last failed login happened' AFTER `failed_logins`, ADD `locked_until` datetime DEFAULT NULL COMMENT 'Until when account is locked after too many failed logins' AFTER `last_failed_login_at`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UsersLockoutDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `users` DROP COLUMN `failed_logins`, DROP COLUMN `last_failed_login_at`, DROP COLUMN `locked_until`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+// Existing sessions are kept: keys are replaced with their SHA-256
+// hashes, same as new sessions use.
+func SessionsHashedKeysUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `sessions_new` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Session ID', `user_id` int(11) NOT NULL COMMENT 'User ID', `key_hash` varchar(64) NOT NULL COMMENT 'SHA-256 of session key', `ip` varchar(45) NOT NULL DEFAULT '' COMMENT 'IP address session was seen from last time', `user_agent` varchar(255) NOT NULL DEFAULT '' COMMENT 'User agent session was started with', `created_at` datetime NOT NULL COMMENT 'When user logged in', `last_seen_at` datetime NOT NULL COMMENT 'When session was used last time', PRIMARY KEY (`id`), UNIQUE KEY `key_hash` (`key_hash`), KEY `user_id` (`user_id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Users sessions'"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("INSERT IGNORE INTO `sessions_new` (`user_id`, `key_hash`, `created_at`, `last_seen_at`) SELECT `id`, SHA2(`key`, 256), `issued`, `issued` FROM `sessions`"); err1 != nil {
+		return err1
+	}
+
+	if _, err2 := tx.Exec("DROP TABLE `sessions`"); err2 != nil {
+		return err2
+	}
+
+	if _, err3 := tx.Exec("RENAME TABLE `sessions_new` TO `sessions`"); err3 != nil {
+		return err3
+	}
+
+	return nil
+}
+
+// Keys can't be restored from hashes, so everyone is logged out.
+func SessionsHashedKeysDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `sessions`"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("CREATE TABLE `sessions` (`id` int(11) NOT NULL COMMENT 'User ID', `key` varchar(64) NOT NULL COMMENT 'Session key', `issued` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'When session key was issued') ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Sessions keys'"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
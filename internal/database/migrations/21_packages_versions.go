@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func PackagesVersionsUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `packages_versions` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Snapshot ID', `module` varchar(255) NOT NULL COMMENT 'Module path', `version` varchar(128) NOT NULL COMMENT 'Canonical version', `time` datetime NOT NULL COMMENT 'Version time from .info', `zip_hash` varchar(64) NOT NULL COMMENT 'go.sum hash of module zip', `mod_hash` varchar(64) NOT NULL COMMENT 'go.sum hash of go.mod', `size` bigint(20) NOT NULL DEFAULT 0 COMMENT 'Module zip size in bytes', `stored` boolean NOT NULL DEFAULT true COMMENT 'Are snapshot files in storage?', `pinned` boolean NOT NULL DEFAULT false COMMENT 'Is snapshot kept regardless of retention?', `discrepancy` varchar(16) NOT NULL DEFAULT '' COMMENT 'How upstream differs from snapshot: missing or changed', `checked_at` datetime NULL DEFAULT NULL COMMENT 'When version was compared with upstream last time', `created_at` datetime NOT NULL COMMENT 'When snapshot was made', `last_served_at` datetime NOT NULL COMMENT 'When snapshot was downloaded last time', PRIMARY KEY (`id`), UNIQUE KEY `module_version` (`module`, `version`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Snapshots of served modules versions'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func PackagesVersionsDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `packages_versions`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+// Snapshots which were made before are indexed from their go.mod files
+// by module proxy.
+func PackagesVersionsRequirementsUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `packages_versions_requirements` (`version_id` int(11) NOT NULL COMMENT 'Snapshot ID', `module` varchar(255) NOT NULL COMMENT 'Required module path', `version` varchar(128) NOT NULL COMMENT 'Required version', `indirect` boolean NOT NULL DEFAULT false COMMENT 'Is requirement marked as indirect?', PRIMARY KEY (`version_id`, `module`), KEY `module` (`module`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Requirements from go.mod of snapshotted versions'"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("ALTER TABLE `packages_versions` ADD `requirements_indexed` boolean NOT NULL DEFAULT false COMMENT 'Were requirements read from go.mod?' AFTER `discrepancy`"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func PackagesVersionsRequirementsDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_versions` DROP COLUMN `requirements_indexed`"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("DROP TABLE `packages_versions_requirements`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func PackagesURLsProbesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_urls` ADD `reachable` boolean NULL DEFAULT NULL COMMENT 'Was URL reachable on last probe? NULL if never probed', ADD `probed_at` datetime NULL DEFAULT NULL COMMENT 'When URL was probed last time', ADD `last_success_at` datetime NULL DEFAULT NULL COMMENT 'When URL was reachable last time', ADD `last_error` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Result of last failed probe'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func PackagesURLsProbesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_urls` DROP COLUMN `reachable`, DROP COLUMN `probed_at`, DROP COLUMN `last_success_at`, DROP COLUMN `last_error`"); err != nil {
+		return err
+	}
+
+	return nil
+}
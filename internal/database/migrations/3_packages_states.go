@@ -0,0 +1,51 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func PackagesStatesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages` ADD `state` varchar(16) NOT NULL DEFAULT 'published' COMMENT 'Package lifecycle state' AFTER `original_package_url`, ADD `state_reason` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Why package is in current state' AFTER `state`, ADD `owner_id` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of user who owns package, 0 if nobody' AFTER `state_reason`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("CREATE TABLE `packages_states_changes` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'State change ID', `package_id` int(11) NOT NULL COMMENT 'Package ID', `from_state` varchar(16) NOT NULL COMMENT 'Previous state', `to_state` varchar(16) NOT NULL COMMENT 'New state', `changed_by` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of user who changed state, 0 if changed by magisterctl', `reason` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Why state was changed', `created_at` datetime NOT NULL COMMENT 'When state was changed', PRIMARY KEY (`id`), KEY `package_id` (`package_id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Packages lifecycle states changes history'"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func PackagesStatesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `packages_states_changes`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("ALTER TABLE `packages` DROP COLUMN `state`, DROP COLUMN `state_reason`, DROP COLUMN `owner_id`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func RoutingPoliciesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_urls` ADD `id` int(11) NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'Package URL ID' FIRST, ADD `mirror` varchar(64) NOT NULL DEFAULT '' COMMENT 'Mirror name which routing policies refer to' AFTER `vcs`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("CREATE TABLE `routing_policies` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Policy ID', `package_id` int(11) NOT NULL DEFAULT 0 COMMENT 'Package ID, 0 for global policies', `priority` int(11) NOT NULL DEFAULT 0 COMMENT 'Policies with lower priority are checked first', `match_type` varchar(16) NOT NULL COMMENT 'What is matched: cidr or host', `match_value` varchar(1024) NOT NULL COMMENT 'Comma-separated CIDRs or hosts', `mirror` varchar(64) NOT NULL COMMENT 'Mirror name to use when policy matches', `enabled` boolean NOT NULL DEFAULT true COMMENT 'Is policy enabled?', `created_at` datetime NOT NULL COMMENT 'When policy was created', PRIMARY KEY (`id`), KEY `package_id` (`package_id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Mirror routing policies'"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func RoutingPoliciesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `routing_policies`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("ALTER TABLE `packages_urls` DROP COLUMN `id`, DROP COLUMN `mirror`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
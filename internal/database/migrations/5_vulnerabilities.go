@@ -0,0 +1,51 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func VulnerabilitiesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `vulnerabilities` (`id` varchar(64) NOT NULL COMMENT 'Advisory ID, e.g. GO-2021-0001', `aliases` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Comma-separated advisory aliases, e.g. CVE IDs', `summary` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Advisory summary', `withdrawn` boolean NOT NULL DEFAULT false COMMENT 'Was advisory withdrawn?', `source` varchar(255) NOT NULL DEFAULT '' COMMENT 'Where advisory was imported from', `data` mediumtext NOT NULL COMMENT 'Advisory in OSV format', `modified` datetime NOT NULL COMMENT 'When advisory was modified', `published` datetime NOT NULL COMMENT 'When advisory was published', `imported_at` datetime NOT NULL COMMENT 'When advisory was imported', PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Imported vulnerabilities advisories'"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("CREATE TABLE `vulnerabilities_modules` (`vulnerability_id` varchar(64) NOT NULL COMMENT 'Advisory ID', `module` varchar(255) NOT NULL COMMENT 'Affected module path', PRIMARY KEY (`vulnerability_id`, `module`), KEY `module` (`module`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Modules affected by vulnerabilities'"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func VulnerabilitiesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `vulnerabilities_modules`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("DROP TABLE `vulnerabilities`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func HooksDeliveriesUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `hooks_deliveries` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Delivery ID', `provider` varchar(16) NOT NULL COMMENT 'Git host which sent delivery: github, gitlab, gitea or bitbucket', `event` varchar(64) NOT NULL DEFAULT '' COMMENT 'Event name as sent by provider', `delivery_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'Delivery ID as sent by provider', `repository_url` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Repository URL from payload', `ref` varchar(255) NOT NULL DEFAULT '' COMMENT 'Pushed branch or tag', `status` varchar(16) NOT NULL COMMENT 'Processing result', `message` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Processing details', `packages` varchar(4096) NOT NULL DEFAULT '' COMMENT 'Comma-separated import paths of matched packages', `headers` text NOT NULL COMMENT 'Request headers as JSON', `payload` mediumtext NOT NULL COMMENT 'Request body', `remote_addr` varchar(64) NOT NULL DEFAULT '' COMMENT 'Client IP', `replay_of` int(11) NOT NULL DEFAULT 0 COMMENT 'ID of replayed delivery, 0 if delivery came from provider', `created_at` datetime NOT NULL COMMENT 'When delivery was received', PRIMARY KEY (`id`), KEY `created_at` (`created_at`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Inbound webhooks deliveries log'"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func HooksDeliveriesDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `hooks_deliveries`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func WebhooksUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("CREATE TABLE `webhooks` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Webhook ID', `name` varchar(255) NOT NULL COMMENT 'Webhook name', `url` varchar(1024) NOT NULL COMMENT 'URL events are POSTed to', `secret` varchar(255) NOT NULL DEFAULT '' COMMENT 'HMAC-SHA256 secret for signing payloads', `events` varchar(1024) NOT NULL DEFAULT '*' COMMENT 'Comma-separated events to send, * for all', `enabled` boolean NOT NULL DEFAULT true COMMENT 'Is webhook enabled?', `created_at` datetime NOT NULL COMMENT 'When webhook was created', PRIMARY KEY (`id`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Outgoing webhooks'"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("CREATE TABLE `webhooks_deliveries` (`id` int(11) NOT NULL AUTO_INCREMENT COMMENT 'Delivery ID', `webhook_id` int(11) NOT NULL COMMENT 'Webhook ID', `event` varchar(64) NOT NULL COMMENT 'Event name', `payload` mediumtext NOT NULL COMMENT 'JSON payload', `status` varchar(16) NOT NULL COMMENT 'pending, delivered or failed', `attempts` int(11) NOT NULL DEFAULT 0 COMMENT 'How many times delivery was attempted', `response_code` int(11) NOT NULL DEFAULT 0 COMMENT 'Last HTTP response code', `error` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Last error', `next_attempt_at` datetime NOT NULL COMMENT 'When delivery should be attempted next time', `created_at` datetime NOT NULL COMMENT 'When delivery was created', `updated_at` datetime NOT NULL COMMENT 'When delivery was attempted last time', PRIMARY KEY (`id`), KEY `webhook_id` (`webhook_id`), KEY `status_next_attempt_at` (`status`, `next_attempt_at`)) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COMMENT='Outgoing webhooks deliveries'"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func WebhooksDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("DROP TABLE `webhooks_deliveries`;"); err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("DROP TABLE `webhooks`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
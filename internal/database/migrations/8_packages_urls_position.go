@@ -0,0 +1,48 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package migrations
+
+import (
+	// stdlib
+	"database/sql"
+)
+
+func PackagesURLsPositionUp(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_urls` ADD `position` int(11) NOT NULL DEFAULT 0 COMMENT 'URL position in package URLs list, first enabled URL is the default one' AFTER `enabled`;"); err != nil {
+		return err
+	}
+
+	// Keep current order.
+	if _, err1 := tx.Exec("UPDATE `packages_urls` SET `position`=`id`;"); err1 != nil {
+		return err1
+	}
+
+	return nil
+}
+
+func PackagesURLsPositionDown(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE `packages_urls` DROP COLUMN `position`;"); err != nil {
+		return err
+	}
+
+	return nil
+}
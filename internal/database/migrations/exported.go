@@ -35,6 +35,28 @@ func Process(db *sql.DB) {
 
 	goose.SetDialect("mysql")
 	goose.AddNamedMigration("1_initial.go", InitialUp, InitialDown)
+	goose.AddNamedMigration("2_packages_urls_vcs.go", PackagesURLsVCSUp, PackagesURLsVCSDown)
+	goose.AddNamedMigration("3_packages_states.go", PackagesStatesUp, PackagesStatesDown)
+	goose.AddNamedMigration("4_routing_policies.go", RoutingPoliciesUp, RoutingPoliciesDown)
+	goose.AddNamedMigration("5_vulnerabilities.go", VulnerabilitiesUp, VulnerabilitiesDown)
+	goose.AddNamedMigration("6_hooks_deliveries.go", HooksDeliveriesUp, HooksDeliveriesDown)
+	goose.AddNamedMigration("7_webhooks.go", WebhooksUp, WebhooksDown)
+	goose.AddNamedMigration("8_packages_urls_position.go", PackagesURLsPositionUp, PackagesURLsPositionDown)
+	goose.AddNamedMigration("9_users_management.go", UsersManagementUp, UsersManagementDown)
+	goose.AddNamedMigration("10_settings.go", SettingsUp, SettingsDown)
+	goose.AddNamedMigration("11_audit_log.go", AuditLogUp, AuditLogDown)
+	goose.AddNamedMigration("12_users_roles.go", UsersRolesUp, UsersRolesDown)
+	goose.AddNamedMigration("13_users_registration.go", UsersRegistrationUp, UsersRegistrationDown)
+	goose.AddNamedMigration("14_users_two_factor.go", UsersTwoFactorUp, UsersTwoFactorDown)
+	goose.AddNamedMigration("15_users_auth_provider.go", UsersAuthProviderUp, UsersAuthProviderDown)
+	goose.AddNamedMigration("16_users_access_tokens.go", UsersAccessTokensUp, UsersAccessTokensDown)
+	goose.AddNamedMigration("17_users_password_phc.go", UsersPasswordPHCUp, UsersPasswordPHCDown)
+	goose.AddNamedMigration("18_users_lockout.go", UsersLockoutUp, UsersLockoutDown)
+	goose.AddNamedMigration("19_sessions_hashed_keys.go", SessionsHashedKeysUp, SessionsHashedKeysDown)
+	goose.AddNamedMigration("20_users_oidc_subject.go", UsersOIDCSubjectUp, UsersOIDCSubjectDown)
+	goose.AddNamedMigration("21_packages_versions.go", PackagesVersionsUp, PackagesVersionsDown)
+	goose.AddNamedMigration("22_packages_versions_requirements.go", PackagesVersionsRequirementsUp, PackagesVersionsRequirementsDown)
+	goose.AddNamedMigration("23_packages_urls_probes.go", PackagesURLsProbesUp, PackagesURLsProbesDown)
 
 	err := goose.Up(db, ".")
 	if err != nil {
@@ -42,3 +64,8 @@ func Process(db *sql.DB) {
 	}
 
 }
+
+// Version returns version database is migrated to.
+func Version(db *sql.DB) (int64, error) {
+	return goose.GetDBVersion(db)
+}
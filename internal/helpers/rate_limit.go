@@ -0,0 +1,85 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package helpers
+
+import (
+	// stdlib
+	"sync"
+	"time"
+)
+
+// RateLimiter allows not more than passed count of attempts per key (e.g. client's
+// IP address) during sliding window. State is kept in memory, so it's
+// reset on restart.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	attempts      map[string][]time.Time
+	attemptsMutex sync.Mutex
+}
+
+// NewRateLimiter creates rate limiter which allows passed count of
+// attempts per key during window.
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:    limit,
+		window:   window,
+		attempts: make(map[string][]time.Time),
+	}
+}
+
+// Allow records attempt for passed key and returns false if limit was
+// already reached.
+func (rl *RateLimiter) Allow(key string) bool {
+	rl.attemptsMutex.Lock()
+	defer rl.attemptsMutex.Unlock()
+
+	now := time.Now()
+	rl.cleanup(now)
+
+	if len(rl.attempts[key]) >= rl.limit {
+		return false
+	}
+
+	rl.attempts[key] = append(rl.attempts[key], now)
+	return true
+}
+
+// Forgets attempts which are out of window. Should be called with
+// mutex locked.
+func (rl *RateLimiter) cleanup(now time.Time) {
+	for key, attempts := range rl.attempts {
+		var fresh []time.Time
+		for _, attempt := range attempts {
+			if now.Sub(attempt) < rl.window {
+				fresh = append(fresh, attempt)
+			}
+		}
+
+		if len(fresh) == 0 {
+			delete(rl.attempts, key)
+		} else {
+			rl.attempts[key] = fresh
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package helpers
+
+import (
+	// stdlib
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares two semantic versions, with or without "v"
+// prefix. Returns -1 if a < b, 0 if a == b and 1 if a > b. OSV uses "0"
+// as "since the beginning", so missing parts are treated as zeros.
+// Build metadata is ignored.
+func CompareVersions(a string, b string) int {
+	aCore, aPre := splitVersion(a)
+	bCore, bPre := splitVersion(b)
+
+	for i := 0; i < 3; i++ {
+		if c := compareNumbers(aCore[i], bCore[i]); c != 0 {
+			return c
+		}
+	}
+
+	// Version without pre-release is greater than one with it.
+	switch {
+	case aPre == "" && bPre == "":
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+
+	aIDs := strings.Split(aPre, ".")
+	bIDs := strings.Split(bPre, ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		if c := comparePrereleaseIDs(aIDs[i], bIDs[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(aIDs), len(bIDs))
+}
+
+// Splits version into major, minor and patch parts and pre-release.
+func splitVersion(version string) ([3]string, string) {
+	version = strings.TrimPrefix(version, "v")
+	if idx := strings.Index(version, "+"); idx != -1 {
+		version = version[:idx]
+	}
+
+	var pre string
+	if idx := strings.Index(version, "-"); idx != -1 {
+		pre = version[idx+1:]
+		version = version[:idx]
+	}
+
+	core := [3]string{"0", "0", "0"}
+	for i, part := range strings.SplitN(version, ".", 3) {
+		if part != "" {
+			core[i] = part
+		}
+	}
+
+	return core, pre
+}
+
+// Compares numeric identifiers without overflowing on long ones.
+func compareNumbers(a string, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return compareInts(len(a), len(b))
+	}
+
+	return strings.Compare(a, b)
+}
+
+// Numeric identifiers have lower precedence than alphanumeric ones.
+func comparePrereleaseIDs(a string, b string) int {
+	_, aErr := strconv.ParseUint(a, 10, 64)
+	_, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareNumbers(a, b)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package http
+
+import (
+	// stdlib
+	"net/http"
+	"strings"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// TokenChecker checks access token passed with request and returns ID
+// of user it belongs to.
+type TokenChecker func(ec echo.Context, token string) (uid int, ok bool)
+
+var tokenChecker TokenChecker
+
+// SetTokenChecker sets function which checks access tokens. Requests
+// without session cookie might be authorized with such tokens.
+func SetTokenChecker(f TokenChecker) {
+	tokenChecker = f
+}
+
+// Returns access token passed as Bearer token or as HTTP Basic password
+// (that's what go tool sends from .netrc).
+func requestToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	}
+
+	if _, password, ok := r.BasicAuth(); ok {
+		return password
+	}
+
+	return ""
+}
+
+// Returns true if request is authorized with Bearer token. Browsers
+// never send such header by themselves, so these requests can't be
+// forged and don't need CSRF token. HTTP Basic credentials are sent by
+// browsers automatically and aren't exempt.
+func isBearerRequest(ec echo.Context) bool {
+	return strings.HasPrefix(ec.Request().Header.Get("Authorization"), "Bearer ")
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package http
+
+import (
+	// stdlib
+	"net"
+	"net/http"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Checks if passed IP belongs to trusted proxy. List is parsed on every
+// check because it might be changed thru web interface, it's short and
+// already validated by settings.
+func isTrustedProxy(ip net.IP) bool {
+	for _, proxy := range settings.List("http.trusted_proxies") {
+		network, err := ParseCIDR(proxy)
+		if err != nil {
+			log.Error().Msgf("Invalid trusted proxy address '%s': %s", proxy, err.Error())
+			continue
+		}
+
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP returns address of client which made request. Proxy headers
+// are taken into account only if request came from trusted proxy, so
+// clients can't spoof their addresses.
+func ClientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isTrustedProxy(ip) {
+		return ip
+	}
+
+	// X-Forwarded-For is a list of addresses, each proxy appends
+	// address it got request from. Walk it from the end and skip our
+	// proxies, first untrusted address is a client.
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		addresses := strings.Split(xff, ",")
+		for i := len(addresses) - 1; i >= 0; i-- {
+			forwarded := net.ParseIP(strings.TrimSpace(addresses[i]))
+			if forwarded == nil {
+				break
+			}
+
+			ip = forwarded
+			if !isTrustedProxy(forwarded) {
+				break
+			}
+		}
+
+		return ip
+	}
+
+	if realIP := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+		return realIP
+	}
+
+	return ip
+}
+
+// ParseCIDR parses CIDR notation or single IP address (which is treated
+// as /32 or /128 network).
+func ParseCIDR(s string) (*net.IPNet, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		if ip := net.ParseIP(s); ip != nil {
+			if ip.To4() != nil {
+				s += "/32"
+			} else {
+				s += "/128"
+			}
+		}
+	}
+
+	_, network, err := net.ParseCIDR(s)
+	return network, err
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package http
+
+import (
+	// stdlib
+	"strings"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+var (
+	csrfExemptEndpoints []string
+)
+
+// AddCSRFExemptEndpoint adds endpoint prefix to a list of endpoints
+// which aren't protected with CSRF tokens. It is meant for API
+// endpoints which are called by tools and not by browsers.
+func AddCSRFExemptEndpoint(prefix string) {
+	csrfExemptEndpoints = append(csrfExemptEndpoints, prefix)
+}
+
+// Returns true if CSRF token shouldn't be checked for current request.
+func csrfSkipper(ec echo.Context) bool {
+	if isBearerRequest(ec) {
+		return true
+	}
+
+	for _, ep := range csrfExemptEndpoints {
+		if strings.HasPrefix(ec.Request().URL.Path, ep) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -42,12 +42,14 @@ func Initialize() {
 	log.Info().Msg("Initializing HTTP server...")
 
 	authRequiredEndpoints = []string{}
+	csrfExemptEndpoints = []string{}
 
 	E = echo.New()
 	E.Use(echoReqLogger())
 	E.Use(middleware.Recover())
 	E.Use(loginStateChecker())
 	E.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
+		Skipper:     csrfSkipper,
 		TokenLookup: "form:_magcsrf",
 		ContextKey:  "CSRFTOKEN",
 	}))
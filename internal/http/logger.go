@@ -30,7 +30,7 @@ import (
 // Logs Echo requests.
 func echoReqLog(ec echo.Context, next echo.HandlerFunc) error {
 	log.Info().
-		Str("IP", ec.RealIP()).
+		Str("IP", ClientIP(ec.Request()).String()).
 		Str("Host", ec.Request().Host).
 		Str("Method", ec.Request().Method).
 		Str("Path", ec.Request().URL.Path).
@@ -45,13 +45,25 @@ func loginStateCheck(ec echo.Context, next echo.HandlerFunc) error {
 		log.Debug().Msgf("No s3ss1onk3y cookie, user isn't logged in")
 	}
 
-	// Check cookie validity.
-	if sessionkey != nil {
-		uid, authed := sessionkeys.CheckSessionKey(sessionkey.Value)
-		if authed {
+	// Check cookie validity. Requests with Bearer token aren't protected
+	// with CSRF tokens and so are authorized only by that token.
+	if sessionkey != nil && !isBearerRequest(ec) {
+		if s := sessionkeys.CheckSessionKey(sessionkey.Value, ClientIP(ec.Request()).String()); s != nil {
 			log.Debug().Msg("User is authorized")
 			ec.Set("AUTHORIZED", true)
-			ec.Set("UID", uid)
+			ec.Set("UID", s.UserID)
+			ec.Set("SESSION", s)
+		}
+	}
+
+	// Tools can't keep session cookie and use access tokens instead.
+	if !ec.Get("AUTHORIZED").(bool) && tokenChecker != nil {
+		if token := requestToken(ec.Request()); token != "" {
+			if uid, ok := tokenChecker(ec, token); ok {
+				log.Debug().Msg("User is authorized with access token")
+				ec.Set("AUTHORIZED", true)
+				ec.Set("UID", uid)
+			}
 		}
 	}
 
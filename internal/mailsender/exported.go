@@ -26,7 +26,7 @@ import (
 	"crypto/tls"
 
 	// local
-	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/settings"
 	"github.com/welltrainedfolks/magister/internal/templater"
 
 	// other
@@ -48,13 +48,14 @@ func SendMail(templateName string, data map[string]string) {
 
 		var shouldAuth bool
 
-		if config.Config.MailSender.User != "" {
+		if settings.String("mailsender.user") != "" {
 			shouldAuth = true
 		}
 
 		if shouldAuth {
 			//sendMailWithAuth(to, templateName, data)
 			log.Error().Msg("Mail sender cannot authorize at mail servers! Will not send any email!")
+			recordFailure("authorization at mail servers isn't supported")
 		} else {
 			sendMailWithoutAuth(templateName, data)
 		}
@@ -68,13 +69,17 @@ func sendMailWithoutAuth(templateName string, data map[string]string) {
 
 	mail := email.NewEmail()
 	mail.Headers.Set("Content-Transfer-Encoding", "quoted-printable")
-	mail.From = config.Config.MailSender.From
+	mail.From = settings.String("mailsender.from")
 	// ToDo: multiple recipients.
 	mail.To = []string{data["mail.to"]}
 	mail.Subject = data["mail.subject"]
 	mail.Text = []byte(tpl)
-	err := mail.SendWithTLS(config.Config.MailSender.Host, nil, &tls.Config{InsecureSkipVerify: true})
+	err := mail.SendWithTLS(settings.String("mailsender.host"), nil, &tls.Config{InsecureSkipVerify: true})
 	if err != nil {
 		log.Error().Msgf("Failed to send mail: %s", err.Error())
+		recordFailure(err.Error())
+		return
 	}
+
+	recordSent()
 }
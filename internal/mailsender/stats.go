@@ -0,0 +1,65 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mailsender
+
+import (
+	// stdlib
+	"sync"
+	"time"
+)
+
+// Stats describes mails delivery since MAGISTER was started.
+type Stats struct {
+	Sent          int        `json:"sent"`
+	Failed        int        `json:"failed"`
+	LastError     string     `json:"last_error"`
+	LastFailureAt *time.Time `json:"last_failure_at"`
+}
+
+var (
+	stats      Stats
+	statsMutex sync.Mutex
+)
+
+// GetStats returns mails delivery statistics.
+func GetStats() Stats {
+	statsMutex.Lock()
+	defer statsMutex.Unlock()
+
+	return stats
+}
+
+func recordSent() {
+	statsMutex.Lock()
+	stats.Sent++
+	statsMutex.Unlock()
+}
+
+func recordFailure(message string) {
+	now := time.Now().UTC()
+
+	statsMutex.Lock()
+	stats.Failed++
+	stats.LastError = message
+	stats.LastFailureAt = &now
+	statsMutex.Unlock()
+}
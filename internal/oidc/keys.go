@@ -0,0 +1,185 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oidc
+
+import (
+	// stdlib
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"time"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// JSON Web Key, only fields needed for signature verification.
+type jwk struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	Use     string `json:"use"`
+	// RSA.
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC.
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// Returns key with passed ID which tokens are signed with. Keys are
+// refetched if they're stale or if key is unknown.
+func (p *Provider) key(keyID string) (interface{}, error) {
+	p.keysMutex.Lock()
+	defer p.keysMutex.Unlock()
+
+	key, found := p.findKey(keyID)
+	stale := time.Since(p.keysFetchedAt) > cacheTTL
+	if (found && !stale) || time.Since(p.keysFetchedAt) < refreshInterval {
+		if !found {
+			return nil, errors.New("unknown signing key '" + keyID + "'")
+		}
+
+		return key, nil
+	}
+
+	if err := p.fetchKeys(); err != nil {
+		// Stale key is better than nothing if provider is having
+		// troubles.
+		if found {
+			log.Warn().Msgf("Failed to refresh signing keys of '%s', using cached ones: %s", p.Issuer, err.Error())
+			return key, nil
+		}
+
+		return nil, err
+	}
+
+	key, found = p.findKey(keyID)
+	if !found {
+		return nil, errors.New("unknown signing key '" + keyID + "'")
+	}
+
+	return key, nil
+}
+
+// Returns key with passed ID. Token might come without key ID if
+// provider has only one key.
+func (p *Provider) findKey(keyID string) (interface{}, bool) {
+	if keyID == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[keyID]
+	return key, ok
+}
+
+// Fetches provider's signing keys.
+func (p *Provider) fetchKeys() error {
+	set := &struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	if err := getJSON(p.JWKSURI, set); err != nil {
+		return errors.New("failed to fetch signing keys: " + err.Error())
+	}
+
+	keys := make(map[string]interface{})
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			log.Warn().Msgf("Skipping signing key '%s' of '%s': %s", k.KeyID, p.Issuer, err.Error())
+			continue
+		}
+
+		keys[k.KeyID] = key
+	}
+
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+	return nil
+}
+
+// Returns public key in form JWT library expects.
+func (k *jwk) publicKey() (interface{}, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err1 := decodeBigInt(k.E)
+		if err1 != nil {
+			return nil, err1
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.New("unsupported curve '" + k.Curve + "'")
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err1 := decodeBigInt(k.Y)
+		if err1 != nil {
+			return nil, err1
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, errors.New("unsupported key type '" + k.KeyType + "'")
+}
+
+// Decodes base64url-encoded big-endian number.
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oidc
+
+import (
+	// stdlib
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Timeout for requests to identity provider.
+	requestTimeout = 10 * time.Second
+	// How long discovery document and signing keys are cached.
+	cacheTTL = time.Hour
+	// Signing keys are refetched when token is signed by unknown key
+	// (e.g. after keys rotation), but not more often than this.
+	refreshInterval = time.Minute
+)
+
+var httpClient = &http.Client{Timeout: requestTimeout}
+
+// Provider is an identity provider's configuration obtained with
+// discovery.
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+
+	discoveredAt time.Time
+	// Signing keys by key ID.
+	keys          map[string]interface{}
+	keysFetchedAt time.Time
+	keysMutex     sync.Mutex
+}
+
+var (
+	// Discovered providers by issuer.
+	providers      = make(map[string]*Provider)
+	providersMutex sync.Mutex
+)
+
+// Discover returns configuration of identity provider with passed
+// issuer URL. It's cached, so might be called on every login.
+func Discover(issuer string) (*Provider, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	if p, ok := providers[issuer]; ok && time.Since(p.discoveredAt) < cacheTTL {
+		return p, nil
+	}
+
+	p := &Provider{}
+	if err := getJSON(issuer+"/.well-known/openid-configuration", p); err != nil {
+		return nil, errors.New("discovery failed: " + err.Error())
+	}
+
+	// Issuer in discovery document must match, otherwise tokens will
+	// never be valid.
+	if strings.TrimSuffix(p.Issuer, "/") != issuer {
+		return nil, errors.New("discovery document is for issuer '" + p.Issuer + "', not '" + issuer + "'")
+	}
+
+	if p.AuthorizationEndpoint == "" || p.TokenEndpoint == "" || p.JWKSURI == "" {
+		return nil, errors.New("discovery document lacks authorization, token or keys endpoint")
+	}
+
+	p.discoveredAt = time.Now()
+	providers[issuer] = p
+	return p, nil
+}
+
+// AuthCodeURL returns URL user should be sent to for authorization code
+// flow with PKCE.
+func (p *Provider) AuthCodeURL(clientID string, redirectURI string, scopes []string, state string, nonce string, verifier string) string {
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(p.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return p.AuthorizationEndpoint + separator + params.Encode()
+}
+
+// Exchange exchanges authorization code for ID token.
+func (p *Provider) Exchange(clientID string, clientSecret string, redirectURI string, code string, verifier string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {clientID},
+		"code_verifier": {verifier},
+	}
+
+	req, err := http.NewRequest("POST", p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+
+	resp, err1 := httpClient.Do(req)
+	if err1 != nil {
+		return "", err1
+	}
+	defer resp.Body.Close()
+
+	body, err2 := ioutil.ReadAll(resp.Body)
+	if err2 != nil {
+		return "", err2
+	}
+
+	tokens := &struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+	if err3 := json.Unmarshal(body, tokens); err3 != nil {
+		return "", errors.New("token endpoint returned " + resp.Status + " with invalid JSON")
+	}
+
+	if tokens.Error != "" {
+		return "", errors.New("token endpoint returned error '" + tokens.Error + "': " + tokens.ErrorDescription)
+	}
+
+	if resp.StatusCode != http.StatusOK || tokens.IDToken == "" {
+		return "", errors.New("token endpoint returned " + resp.Status + " without ID token")
+	}
+
+	return tokens.IDToken, nil
+}
+
+// GenerateState returns random value for state, nonce or PKCE code
+// verifier.
+func GenerateState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Returns PKCE S256 code challenge for passed verifier.
+func codeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Requests passed URL and decodes JSON response into passed value.
+func getJSON(url string, v interface{}) error {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(url + " returned " + resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
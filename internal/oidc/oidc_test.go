@@ -0,0 +1,310 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oidc
+
+import (
+	// stdlib
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	// other
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	testClientID = "magister"
+	testKeyID    = "test-key"
+)
+
+// Identity provider which serves discovery document, signing keys and
+// token endpoint. Token endpoint accepts only expected code with code
+// verifier matching expected PKCE challenge.
+type testProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	code      string
+	challenge string
+	idToken   string
+
+	discoveryRequests int
+	keysRequests      int
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate signing key: %s", err.Error())
+	}
+
+	tp := &testProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		tp.discoveryRequests++
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 tp.server.URL,
+			"authorization_endpoint": tp.server.URL + "/authorize",
+			"token_endpoint":         tp.server.URL + "/token",
+			"jwks_uri":               tp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		tp.keysRequests++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kid": testKeyID,
+					"kty": "RSA",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+				// Encryption keys are skipped.
+				{"kid": "enc-key", "kty": "RSA", "use": "enc", "n": "AQAB", "e": "AQAB"},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("code") != tp.code || r.PostForm.Get("client_id") != testClientID || codeChallenge(r.PostForm.Get("code_verifier")) != tp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "code or verifier is invalid"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "token_type": "Bearer", "id_token": tp.idToken})
+	})
+
+	tp.server = httptest.NewServer(mux)
+	return tp
+}
+
+// Returns valid claims of ID token with passed nonce.
+func (tp *testProvider) claims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":   tp.server.URL,
+		"sub":   "248289761001",
+		"aud":   testClientID,
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"nonce": nonce,
+	}
+}
+
+// Returns ID token with passed claims signed by passed key.
+func sign(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign ID token: %s", err.Error())
+	}
+
+	return signed
+}
+
+func TestDiscoverAndKeys(t *testing.T) {
+	tp := newTestProvider(t)
+	defer tp.server.Close()
+
+	p, err := Discover(tp.server.URL + "/")
+	if err != nil {
+		t.Fatalf("Discovery failed: %s", err.Error())
+	}
+
+	if p.Issuer != tp.server.URL || p.TokenEndpoint != tp.server.URL+"/token" || p.JWKSURI != tp.server.URL+"/keys" {
+		t.Errorf("Unexpected provider configuration: %+v", p)
+	}
+
+	if _, err1 := Discover(tp.server.URL); err1 != nil || tp.discoveryRequests != 1 {
+		t.Errorf("Expected discovery document to be cached, it was requested %d times (%v)", tp.discoveryRequests, err1)
+	}
+
+	if _, err2 := p.key(testKeyID); err2 != nil {
+		t.Fatalf("Failed to get signing key: %s", err2.Error())
+	}
+
+	if _, err3 := p.key("enc-key"); err3 == nil {
+		t.Error("Expected encryption key to be skipped")
+	}
+
+	if _, err4 := p.key(testKeyID); err4 != nil || tp.keysRequests != 1 {
+		t.Errorf("Expected signing keys to be cached, they were requested %d times (%v)", tp.keysRequests, err4)
+	}
+}
+
+func TestDiscoverIssuerMismatch(t *testing.T) {
+	tp := newTestProvider(t)
+	defer tp.server.Close()
+
+	// Same server under other name.
+	other := strings.Replace(tp.server.URL, "127.0.0.1", "localhost", 1)
+	if _, err := Discover(other); err == nil {
+		t.Error("Expected discovery document of other issuer to be rejected")
+	}
+}
+
+func TestExchangePKCE(t *testing.T) {
+	tp := newTestProvider(t)
+	defer tp.server.Close()
+
+	p, err := Discover(tp.server.URL)
+	if err != nil {
+		t.Fatalf("Discovery failed: %s", err.Error())
+	}
+
+	verifier, _ := GenerateState()
+	authURL, err1 := url.Parse(p.AuthCodeURL(testClientID, "https://go.example.com/login/oidc/callback/", []string{"openid", "email"}, "state", "nonce", verifier))
+	if err1 != nil {
+		t.Fatalf("Invalid authorization URL: %s", err1.Error())
+	}
+
+	query := authURL.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") != codeChallenge(verifier) || query.Get("scope") != "openid email" {
+		t.Errorf("Unexpected authorization URL: %s", authURL.String())
+	}
+
+	tp.code = "auth-code"
+	tp.challenge = query.Get("code_challenge")
+	tp.idToken = sign(t, tp.key, tp.claims("nonce"))
+
+	idToken, err2 := p.Exchange(testClientID, "secret", "https://go.example.com/login/oidc/callback/", "auth-code", verifier)
+	if err2 != nil || idToken != tp.idToken {
+		t.Errorf("Expected code to be exchanged for ID token, got error %v", err2)
+	}
+
+	if _, err3 := p.Exchange(testClientID, "secret", "https://go.example.com/login/oidc/callback/", "auth-code", "other-verifier"); err3 == nil || !strings.Contains(err3.Error(), "invalid_grant") {
+		t.Errorf("Expected wrong code verifier to be rejected, got %v", err3)
+	}
+
+	if _, err4 := p.Exchange(testClientID, "secret", "https://go.example.com/login/oidc/callback/", "other-code", verifier); err4 == nil {
+		t.Error("Expected wrong code to be rejected")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tp := newTestProvider(t)
+	defer tp.server.Close()
+
+	p, err := Discover(tp.server.URL)
+	if err != nil {
+		t.Fatalf("Discovery failed: %s", err.Error())
+	}
+
+	claims, err1 := p.Verify(sign(t, tp.key, tp.claims("nonce")), testClientID, "nonce")
+	if err1 != nil {
+		t.Fatalf("Expected valid ID token to be accepted: %s", err1.Error())
+	}
+
+	if claims.String("sub") != "248289761001" {
+		t.Errorf("Unexpected subject '%s'", claims.String("sub"))
+	}
+
+	otherKey, err2 := rsa.GenerateKey(rand.Reader, 2048)
+	if err2 != nil {
+		t.Fatalf("Failed to generate other key: %s", err2.Error())
+	}
+
+	cases := []struct {
+		name   string
+		token  func() string
+		reason string
+	}{
+		{"issuer", func() string {
+			c := tp.claims("nonce")
+			c["iss"] = "https://evil.example.com"
+			return sign(t, tp.key, c)
+		}, "issued by"},
+		{"audience", func() string {
+			c := tp.claims("nonce")
+			c["aud"] = []string{"other-client"}
+			return sign(t, tp.key, c)
+		}, "other client"},
+		{"authorized party", func() string {
+			c := tp.claims("nonce")
+			c["aud"] = []string{testClientID, "other-client"}
+			c["azp"] = "other-client"
+			return sign(t, tp.key, c)
+		}, "authorized party"},
+		{"nonce", func() string {
+			return sign(t, tp.key, tp.claims("other-nonce"))
+		}, "nonce"},
+		{"expiration", func() string {
+			c := tp.claims("nonce")
+			c["exp"] = time.Now().Add(-time.Hour).Unix()
+			return sign(t, tp.key, c)
+		}, "expired"},
+		{"missing expiration", func() string {
+			c := tp.claims("nonce")
+			delete(c, "exp")
+			return sign(t, tp.key, c)
+		}, "expired"},
+		{"issued in future", func() string {
+			c := tp.claims("nonce")
+			c["iat"] = time.Now().Add(time.Hour).Unix()
+			return sign(t, tp.key, c)
+		}, "future"},
+		{"signature", func() string {
+			return sign(t, otherKey, tp.claims("nonce"))
+		}, "invalid ID token"},
+		{"tampered payload", func() string {
+			parts := strings.Split(sign(t, tp.key, tp.claims("nonce")), ".")
+			c := tp.claims("nonce")
+			c["sub"] = "admin"
+			payload, _ := json.Marshal(c)
+			return parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+		}, "invalid ID token"},
+		{"HMAC signed with client secret", func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, tp.claims("nonce"))
+			signed, _ := token.SignedString([]byte("secret"))
+			return signed
+		}, "invalid ID token"},
+		{"unsigned", func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodNone, tp.claims("nonce"))
+			signed, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return signed
+		}, "invalid ID token"},
+	}
+
+	for _, c := range cases {
+		_, err3 := p.Verify(c.token(), testClientID, "nonce")
+		if err3 == nil {
+			t.Errorf("Expected ID token with bad %s to be rejected", c.name)
+			continue
+		}
+
+		if !strings.Contains(err3.Error(), c.reason) {
+			t.Errorf("Expected ID token with bad %s to be rejected because of %s, got: %s", c.name, c.reason, err3.Error())
+		}
+	}
+}
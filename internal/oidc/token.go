@@ -0,0 +1,128 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oidc
+
+import (
+	// stdlib
+	"errors"
+	"time"
+
+	// other
+	"github.com/dgrijalva/jwt-go"
+)
+
+// Allowed difference between our and provider's clocks.
+const leeway = time.Minute
+
+// Only asymmetric algorithms are accepted, HMAC would make client
+// secret a signing key and "none" isn't a signature at all.
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Claims are ID token's claims.
+type Claims map[string]interface{}
+
+// String returns string claim or empty string if there is no such
+// claim or it isn't a string.
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Strings returns claim which might be a string or a list of strings.
+func (c Claims) Strings(name string) []string {
+	switch value := c[name].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+// Returns numeric date claim.
+func (c Claims) time(name string) (time.Time, bool) {
+	value, ok := c[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(value), 0), true
+}
+
+// Verify checks ID token's signature, issuer, audience, expiration and
+// nonce, and returns it's claims.
+func (p *Provider) Verify(rawIDToken string, clientID string, nonce string) (Claims, error) {
+	parser := &jwt.Parser{ValidMethods: validMethods, SkipClaimsValidation: true}
+	mapClaims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(rawIDToken, mapClaims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return p.key(keyID)
+	})
+	if err != nil {
+		return nil, errors.New("invalid ID token: " + err.Error())
+	}
+
+	claims := Claims(mapClaims)
+
+	if claims.String("iss") != p.Issuer {
+		return nil, errors.New("ID token was issued by '" + claims.String("iss") + "'")
+	}
+
+	audience := claims.Strings("aud")
+	var audienceValid bool
+	for _, aud := range audience {
+		if aud == clientID {
+			audienceValid = true
+		}
+	}
+	if !audienceValid {
+		return nil, errors.New("ID token was issued for other client")
+	}
+
+	if azp := claims.String("azp"); len(audience) > 1 && azp != "" && azp != clientID {
+		return nil, errors.New("ID token was issued for other authorized party")
+	}
+
+	now := time.Now()
+	exp, ok := claims.time("exp")
+	if !ok || now.After(exp.Add(leeway)) {
+		return nil, errors.New("ID token has expired")
+	}
+
+	if iat, ok := claims.time("iat"); ok && now.Before(iat.Add(-leeway)) {
+		return nil, errors.New("ID token was issued in future")
+	}
+
+	if claims.String("nonce") != nonce {
+		return nil, errors.New("ID token's nonce doesn't match")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package passwords
+
+import (
+	// stdlib
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	// other
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Supported hashing algorithms.
+const (
+	Argon2id = "argon2id"
+	Scrypt   = "scrypt"
+	Bcrypt   = "bcrypt"
+)
+
+// Parameters new hashes are made with. They're stored in every hash,
+// so they might be raised without breaking existing hashes.
+const (
+	// RFC 9106 second recommended option.
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+
+	// N = 2^15, same as legacy hashes used.
+	scryptLogN = 15
+	scryptR    = 8
+	scryptP    = 1
+
+	bcryptCost = 12
+
+	saltLength = 16
+	keyLength  = 32
+)
+
+// Limits for parameters of stored hashes, so hash from e.g. imported
+// dump can't make verification take forever or eat all memory.
+const (
+	maxArgon2Memory  = 1024 * 1024
+	maxArgon2Time    = 16
+	maxScryptLogN    = 20
+	maxScryptR       = 32
+	maxScryptP       = 16
+	maxParallelism   = 255
+	minHashKeyLength = 16
+	maxHashKeyLength = 128
+
+	// Hashing megabytes of "password" is a cheap way to waste CPU.
+	maxPasswordLength = 1024
+	// bcrypt ignores everything after 72 bytes.
+	maxBcryptPasswordLength = 72
+)
+
+// Legacy scheme's parameters.
+const (
+	legacyScryptN      = 32768
+	legacyScryptR      = 8
+	legacyScryptP      = 1
+	legacyScryptKeyLen = 32
+)
+
+var (
+	errMalformedHash    = errors.New("malformed password hash")
+	errUnknownAlgorithm = errors.New("unknown password hashing algorithm")
+	errUnsafeParameters = errors.New("password hash parameters are out of allowed range")
+	errPasswordTooLong  = errors.New("password is too long")
+
+	// PHC strings use standard base64 without padding.
+	phcEncoding = base64.RawStdEncoding
+
+	bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+)
+
+// Hash hashes password with passed algorithm and returns it in PHC
+// string format, e.g. "$argon2id$v=19$m=65536,t=3,p=4$salt$hash"
+// (bcrypt uses its own "$2b$..." format).
+func Hash(password string, algorithm string) (string, error) {
+	if len(password) > maxPasswordLength {
+		return "", errPasswordTooLong
+	}
+
+	if algorithm == Bcrypt {
+		if len(password) > maxBcryptPasswordLength {
+			return "", errors.New("bcrypt can't hash passwords longer than 72 bytes")
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+		return string(hash), err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	switch algorithm {
+	case Argon2id:
+		key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, keyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, argon2Memory, argon2Time, argon2Threads, phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+	case Scrypt:
+		key, err := scrypt.Key([]byte(password), salt, 1<<scryptLogN, scryptR, scryptP, keyLength)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", scryptLogN, scryptR, scryptP, phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+	}
+
+	return "", errUnknownAlgorithm
+}
+
+// Verify checks password against hash made by Hash. Comparison is done
+// in constant time.
+func Verify(password string, encoded string) (bool, error) {
+	if len(password) > maxPasswordLength {
+		return false, nil
+	}
+
+	if isBcrypt(encoded) {
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	h, err := parse(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	var key []byte
+	switch h.algorithm {
+	case Argon2id:
+		key = argon2.IDKey([]byte(password), h.salt, uint32(h.params["t"]), uint32(h.params["m"]), uint8(h.params["p"]), uint32(len(h.key)))
+	case Scrypt:
+		key, err = scrypt.Key([]byte(password), h.salt, 1<<uint(h.params["ln"]), h.params["r"], h.params["p"], len(h.key))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1, nil
+}
+
+// NeedsRehash returns true if hash wasn't made with passed algorithm
+// and current parameters, so it should be replaced next time password
+// is known (i.e. on successful login).
+func NeedsRehash(encoded string, algorithm string) bool {
+	if isBcrypt(encoded) {
+		if algorithm != Bcrypt {
+			return true
+		}
+
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return err != nil || cost != bcryptCost
+	}
+
+	h, err := parse(encoded)
+	if err != nil || h.algorithm != algorithm || len(h.key) != keyLength {
+		return true
+	}
+
+	switch h.algorithm {
+	case Argon2id:
+		return h.params["m"] != argon2Memory || h.params["t"] != argon2Time || h.params["p"] != argon2Threads
+	case Scrypt:
+		return h.params["ln"] != scryptLogN || h.params["r"] != scryptR || h.params["p"] != scryptP
+	}
+
+	return true
+}
+
+// IsLegacy returns true if hash was made by scheme used before PHC
+// strings: hex-encoded SHA-256 of scrypt key, with salt stored
+// separately.
+func IsLegacy(encoded string) bool {
+	return encoded != "" && !strings.HasPrefix(encoded, "$")
+}
+
+// VerifyLegacy checks password against legacy hash and salt.
+func VerifyLegacy(password string, salt string, encoded string) bool {
+	if salt == "" || len(password) > maxPasswordLength {
+		return false
+	}
+
+	key, err := scrypt.Key([]byte(password), []byte(salt), legacyScryptN, legacyScryptR, legacyScryptP, legacyScryptKeyLen)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(key)
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(hash[:])), []byte(encoded)) == 1
+}
+
+// Parsed PHC string.
+type phcHash struct {
+	algorithm string
+	params    map[string]int
+	salt      []byte
+	key       []byte
+}
+
+// Parses PHC string and checks that parameters are within limits.
+func parse(encoded string) (*phcHash, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) < 5 || fields[0] != "" {
+		return nil, errMalformedHash
+	}
+
+	h := &phcHash{algorithm: fields[1], params: make(map[string]int)}
+
+	// Argon2 has version field before parameters.
+	rest := fields[2:]
+	if h.algorithm == Argon2id {
+		if len(rest) != 4 || rest[0] != "v="+strconv.Itoa(argon2.Version) {
+			return nil, errMalformedHash
+		}
+		rest = rest[1:]
+	}
+
+	if len(rest) != 3 {
+		return nil, errMalformedHash
+	}
+
+	for _, param := range strings.Split(rest[0], ",") {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return nil, errMalformedHash
+		}
+
+		value, err := strconv.Atoi(kv[1])
+		if err != nil || value < 1 {
+			return nil, errMalformedHash
+		}
+		h.params[kv[0]] = value
+	}
+
+	var err error
+	if h.salt, err = phcEncoding.DecodeString(rest[1]); err != nil {
+		return nil, errMalformedHash
+	}
+
+	if h.key, err = phcEncoding.DecodeString(rest[2]); err != nil {
+		return nil, errMalformedHash
+	}
+
+	if len(h.key) < minHashKeyLength || len(h.key) > maxHashKeyLength {
+		return nil, errUnsafeParameters
+	}
+
+	switch h.algorithm {
+	case Argon2id:
+		if h.params["m"] == 0 || h.params["t"] == 0 || h.params["p"] == 0 {
+			return nil, errMalformedHash
+		}
+
+		if h.params["m"] > maxArgon2Memory || h.params["t"] > maxArgon2Time || h.params["p"] > maxParallelism {
+			return nil, errUnsafeParameters
+		}
+	case Scrypt:
+		if h.params["ln"] == 0 || h.params["r"] == 0 || h.params["p"] == 0 {
+			return nil, errMalformedHash
+		}
+
+		if h.params["ln"] > maxScryptLogN || h.params["r"] > maxScryptR || h.params["p"] > maxScryptP {
+			return nil, errUnsafeParameters
+		}
+	default:
+		return nil, errUnknownAlgorithm
+	}
+
+	return h, nil
+}
+
+func isBcrypt(encoded string) bool {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(encoded, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package passwords
+
+import (
+	// stdlib
+	"fmt"
+	"strings"
+	"testing"
+
+	// other
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Base64 of 16 and 32 zero bytes, valid salt and key for PHC strings.
+const (
+	testSalt = "AAAAAAAAAAAAAAAAAAAAAA"
+	testKey  = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+)
+
+func TestHashVerifyRoundTrip(t *testing.T) {
+	prefixes := map[string]string{
+		Argon2id: "$argon2id$v=19$m=65536,t=3,p=4$",
+		Scrypt:   "$scrypt$ln=15,r=8,p=1$",
+		Bcrypt:   "$2a$12$",
+	}
+
+	for _, algorithm := range []string{Argon2id, Scrypt, Bcrypt} {
+		hash, err := Hash("correct horse", algorithm)
+		if err != nil {
+			t.Fatalf("Failed to hash password with %s: %s", algorithm, err.Error())
+		}
+
+		if !strings.HasPrefix(hash, prefixes[algorithm]) {
+			t.Errorf("Expected %s hash to start with '%s', got '%s'", algorithm, prefixes[algorithm], hash)
+		}
+
+		if ok, err1 := Verify("correct horse", hash); !ok || err1 != nil {
+			t.Errorf("Expected password to match %s hash, got %v (%v)", algorithm, ok, err1)
+		}
+
+		if ok, err2 := Verify("battery staple", hash); ok || err2 != nil {
+			t.Errorf("Expected other password not to match %s hash, got %v (%v)", algorithm, ok, err2)
+		}
+
+		if NeedsRehash(hash, algorithm) {
+			t.Errorf("Expected fresh %s hash not to need rehash", algorithm)
+		}
+
+		// Salt is random, so same password gives different hashes.
+		if other, _ := Hash("correct horse", algorithm); other == hash {
+			t.Errorf("Expected %s hashes of same password to differ", algorithm)
+		}
+	}
+}
+
+func TestHashRejects(t *testing.T) {
+	if _, err := Hash("password", "md5"); err != errUnknownAlgorithm {
+		t.Errorf("Expected unknown algorithm to be rejected, got %v", err)
+	}
+
+	if _, err := Hash(strings.Repeat("a", maxPasswordLength+1), Argon2id); err != errPasswordTooLong {
+		t.Errorf("Expected too long password to be rejected, got %v", err)
+	}
+
+	if _, err := Hash(strings.Repeat("a", maxBcryptPasswordLength+1), Bcrypt); err == nil {
+		t.Error("Expected password longer than 72 bytes to be rejected by bcrypt")
+	}
+
+	if ok, _ := Verify(strings.Repeat("a", maxPasswordLength+1), "$scrypt$ln=15,r=8,p=1$"+testSalt+"$"+testKey); ok {
+		t.Error("Expected too long password not to be verified")
+	}
+}
+
+// Hashes made elsewhere with other parameters are verified with
+// parameters stored in them.
+func TestVerifyStoredParameters(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+
+	argonKey := argon2.IDKey([]byte("correct horse"), salt, 1, 1024, 1, 32)
+	argonHash := fmt.Sprintf("$argon2id$v=19$m=1024,t=1,p=1$%s$%s", phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(argonKey))
+
+	scryptKey, err := scrypt.Key([]byte("correct horse"), salt, 1<<10, 8, 1, 24)
+	if err != nil {
+		t.Fatalf("Failed to make scrypt key: %s", err.Error())
+	}
+	scryptHash := fmt.Sprintf("$scrypt$ln=10,r=8,p=1$%s$%s", phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(scryptKey))
+
+	for _, hash := range []string{argonHash, scryptHash} {
+		if ok, err1 := Verify("correct horse", hash); !ok || err1 != nil {
+			t.Errorf("Expected password to match '%s', got %v (%v)", hash, ok, err1)
+		}
+	}
+}
+
+func TestVerifyLegacy(t *testing.T) {
+	// scrypt(N=32768, r=8, p=1) of "correct horse" with "legacy-salt",
+	// hashed with SHA-256.
+	legacy := "e6037fd8021190a9abc29071ee6a05cdd9232d87ead96ea00e41482c3419bce8"
+
+	if !IsLegacy(legacy) {
+		t.Error("Expected hex hash to be legacy")
+	}
+
+	if !VerifyLegacy("correct horse", "legacy-salt", legacy) {
+		t.Error("Expected password to match legacy hash")
+	}
+
+	if VerifyLegacy("battery staple", "legacy-salt", legacy) {
+		t.Error("Expected other password not to match legacy hash")
+	}
+
+	if VerifyLegacy("correct horse", "other-salt", legacy) || VerifyLegacy("correct horse", "", legacy) {
+		t.Error("Expected legacy hash not to match with other or empty salt")
+	}
+
+	for _, hash := range []string{"", "$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey, "$2b$12$abc"} {
+		if IsLegacy(hash) {
+			t.Errorf("Expected '%s' not to be legacy", hash)
+		}
+	}
+
+	// Legacy hashes are always replaced.
+	if !NeedsRehash(legacy, Argon2id) {
+		t.Error("Expected legacy hash to need rehash")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	lowCost, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Failed to make bcrypt hash: %s", err.Error())
+	}
+
+	cases := []struct {
+		hash      string
+		algorithm string
+		rehash    bool
+	}{
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey, Argon2id, false},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey, Scrypt, true},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey, Bcrypt, true},
+		{"$argon2id$v=19$m=32768,t=3,p=4$" + testSalt + "$" + testKey, Argon2id, true},
+		{"$argon2id$v=19$m=65536,t=2,p=4$" + testSalt + "$" + testKey, Argon2id, true},
+		{"$argon2id$v=19$m=65536,t=3,p=1$" + testSalt + "$" + testKey, Argon2id, true},
+		// Key of 24 bytes.
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey[:32], Argon2id, true},
+		{"$scrypt$ln=15,r=8,p=1$" + testSalt + "$" + testKey, Scrypt, false},
+		{"$scrypt$ln=15,r=8,p=1$" + testSalt + "$" + testKey, Argon2id, true},
+		{"$scrypt$ln=14,r=8,p=1$" + testSalt + "$" + testKey, Scrypt, true},
+		{"$scrypt$ln=15,r=16,p=1$" + testSalt + "$" + testKey, Scrypt, true},
+		{string(lowCost), Bcrypt, true},
+		{string(lowCost), Argon2id, true},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt, Argon2id, true},
+	}
+
+	for _, c := range cases {
+		if rehash := NeedsRehash(c.hash, c.algorithm); rehash != c.rehash {
+			t.Errorf("Expected NeedsRehash('%s', %s) to be %v", c.hash, c.algorithm, c.rehash)
+		}
+	}
+}
+
+func TestParseRejectsMalformed(t *testing.T) {
+	cases := []struct {
+		hash string
+		err  error
+	}{
+		{"", errMalformedHash},
+		{"$argon2id$", errMalformedHash},
+		{"argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$m=65536,t=3,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=16$m=65536,t=3,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey + "$extra", errMalformedHash},
+		{"$argon2id$v=19$m=65536,t,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=lots,t=3,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=0,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=-3,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,p=4$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=3,p=4$not*base64$" + testKey, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$" + testKey + "==", errMalformedHash},
+		{"$scrypt$ln=15,r=8$" + testSalt + "$" + testKey, errMalformedHash},
+		{"$scrypt$ln=15,r=8,p=1$" + testSalt, errMalformedHash},
+		{"$argon2id$v=19$m=65536,t=3,p=4$" + testSalt + "$AAAA", errUnsafeParameters},
+		{"$argon2id$v=19$m=4194304,t=3,p=4$" + testSalt + "$" + testKey, errUnsafeParameters},
+		{"$argon2id$v=19$m=65536,t=100,p=4$" + testSalt + "$" + testKey, errUnsafeParameters},
+		{"$scrypt$ln=30,r=8,p=1$" + testSalt + "$" + testKey, errUnsafeParameters},
+		{"$scrypt$ln=15,r=8,p=64$" + testSalt + "$" + testKey, errUnsafeParameters},
+		{"$pbkdf2-sha256$i=1000$" + testSalt + "$" + testKey, errUnknownAlgorithm},
+	}
+
+	for _, c := range cases {
+		if _, err := parse(c.hash); err != c.err {
+			t.Errorf("Expected parsing '%s' to fail with '%v', got '%v'", c.hash, c.err, err)
+		}
+
+		if ok, err := Verify("correct horse", c.hash); ok || err == nil {
+			t.Errorf("Expected '%s' to be rejected by Verify, got %v (%v)", c.hash, ok, err)
+		}
+	}
+}
@@ -23,65 +23,251 @@ package sessionkeys
 
 import (
 	// stdlib
-	"strings"
+	"database/sql"
 	"time"
 
 	// local
-	"github.com/welltrainedfolks/magister/internal/config"
 	"github.com/welltrainedfolks/magister/internal/database"
 	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/settings"
 
 	// other
 	"github.com/rs/zerolog/log"
 )
 
-type SessionKey struct {
-	ID     int       `db:"id"`
-	Key    string    `db:"key"`
-	Issued time.Time `db:"issued"`
+const (
+	// How often expired sessions are deleted.
+	cleanupInterval = time.Hour
+	// Last seen time isn't updated more often than this, to not write
+	// into database on every request.
+	lastSeenPrecision = time.Minute
+	// Longer user agents are cut.
+	maxUserAgentLength = 255
+)
+
+// Session is user's login on some device. Only session key's hash is
+// stored, key itself is known only to client. IP is the address
+// session was seen from last time.
+type Session struct {
+	ID         int       `db:"id"`
+	UserID     int       `db:"user_id"`
+	KeyHash    string    `db:"key_hash"`
+	IP         string    `db:"ip"`
+	UserAgent  string    `db:"user_agent"`
+	CreatedAt  time.Time `db:"created_at"`
+	LastSeenAt time.Time `db:"last_seen_at"`
 }
 
-func DeleteSessionKey(key string) {
-	_ = database.DB.MustExec("DELETE FROM `sessions` WHERE `key`=?", key)
+// Initialize starts deleting expired sessions.
+func Initialize() {
+	log.Info().Msg("Initializing sessions...")
+
+	go func() {
+		for {
+			cleanup()
+			time.Sleep(cleanupInterval)
+		}
+	}()
 }
 
-func NewSessionKey(uid int) string {
-	log.Debug().Msg("Generating new session key")
-	sk := SessionKey{
-		ID:     uid,
-		Issued: time.Now(),
+// Deletes sessions which expired by either timeout.
+func cleanup() {
+	createdSince, seenSince := validSince()
+	res, err := database.DB.Exec("DELETE FROM `sessions` WHERE `created_at`<=? OR `last_seen_at`<=?", createdSince, seenSince)
+	if err != nil {
+		log.Error().Msgf("Failed to delete expired sessions: %s", err.Error())
+		return
+	}
+
+	if deleted, _ := res.RowsAffected(); deleted != 0 {
+		log.Info().Msgf("Deleted %d expired sessions", deleted)
 	}
+}
+
+// Returns times sessions should be created and last seen after to be
+// valid: absolute timeout counts from login, idle timeout - from last
+// request.
+func validSince() (time.Time, time.Time) {
+	now := time.Now().UTC()
+	createdSince := now.Add(-time.Hour * time.Duration(24*settings.Int("http.session_validity_days")))
+	seenSince := now.Add(-time.Hour * time.Duration(settings.Int("http.session_idle_hours")))
+	return createdSince, seenSince
+}
+
+// IsExpired returns true if session expired by either timeout.
+func (s *Session) IsExpired() bool {
+	createdSince, seenSince := validSince()
+	return !s.CreatedAt.After(createdSince) || !s.LastSeenAt.After(seenSince)
+}
 
-	// Generate key.
-	sk.Key = helpers.GenerateRandomString(32)
-	// Put in database.
-	_, err := database.DB.NamedExec("INSERT INTO `sessions` (`id`, `key`, `issued`) VALUES (:id, :key, :issued)", &sk)
+// NewSessionKey starts session for user with passed ID and returns
+// session key, which should be given to client.
+func NewSessionKey(uid int, ip string, userAgent string) string {
+	log.Debug().Msg("Generating new session key")
+
+	key, err := helpers.GenerateToken(32)
 	if err != nil {
-		log.Error().Msgf("Failed to save session data in database: %s", err.Error())
+		log.Error().Msgf("Failed to generate session key: %s", err.Error())
+		return ""
+	}
+
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	now := time.Now().UTC()
+	s := &Session{
+		UserID:     uid,
+		KeyHash:    helpers.HashToken(key),
+		IP:         ip,
+		UserAgent:  userAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+
+	_, err1 := database.DB.NamedExec("INSERT INTO `sessions` (`user_id`, `key_hash`, `ip`, `user_agent`, `created_at`, `last_seen_at`) VALUES (:user_id, :key_hash, :ip, :user_agent, :created_at, :last_seen_at)", s)
+	if err1 != nil {
+		log.Error().Msgf("Failed to save session data in database: %s", err1.Error())
 		return ""
 	}
 
-	return sk.Key
+	return key
 }
 
-func CheckSessionKey(key string) (int, bool) {
-	// Check if session key exist.
-	sk := &SessionKey{}
-	err := database.DB.Get(sk, "SELECT * FROM `sessions` WHERE `key`=?", key)
+// CheckSessionKey returns session for passed key, or nil if there is no
+// such session, it has expired or it's user doesn't exist or isn't
+// active anymore. Session's last seen time and IP address are updated.
+func CheckSessionKey(key string, ip string) *Session {
+	s := &Session{}
+	err := database.DB.Get(s, "SELECT `sessions`.* FROM `sessions` JOIN `users` ON `users`.`id`=`sessions`.`user_id` WHERE `sessions`.`key_hash`=? AND `users`.`is_active`=1", helpers.HashToken(key))
 	if err != nil {
-		if !strings.Contains(err.Error(), "sql: no rows") {
+		if err != sql.ErrNoRows {
 			log.Error().Msgf("Failed to check session key validity: %s", err.Error())
-			return -1, false
+		}
+		return nil
+	}
+
+	if s.IsExpired() {
+		log.Debug().Msgf("Session #%d expired", s.ID)
+		s.delete()
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if now.Sub(s.LastSeenAt) >= lastSeenPrecision || s.IP != ip {
+		s.LastSeenAt = now
+		s.IP = ip
+		if _, err1 := database.DB.NamedExec("UPDATE `sessions` SET `last_seen_at`=:last_seen_at, `ip`=:ip WHERE `id`=:id", s); err1 != nil {
+			log.Error().Msgf("Failed to update session #%d: %s", s.ID, err1.Error())
 		}
 	}
 
-	// Check key validity.
-	curTime := time.Now().UTC()
-	log.Debug().Msgf("Current time: %v <> Token issuance time: %v <> Sub: %v <> Valid for: %v", curTime, sk.Issued, curTime.Sub(sk.Issued), time.Hour*time.Duration(24*config.Config.HTTP.SessionValidityDays))
-	if curTime.Sub(sk.Issued) > time.Hour*time.Duration(24*config.Config.HTTP.SessionValidityDays) {
-		log.Debug().Msgf("Session expired.")
-		return -1, false
+	return s
+}
+
+// RotateSessionKey replaces key of session with passed key and returns
+// new one. Keys are rotated when user's privileges change, so key
+// which might have leaked before that becomes useless.
+func RotateSessionKey(key string) string {
+	newKey, err := helpers.GenerateToken(32)
+	if err != nil {
+		log.Error().Msgf("Failed to generate session key: %s", err.Error())
+		return ""
 	}
 
-	return sk.ID, true
+	res, err1 := database.DB.Exec("UPDATE `sessions` SET `key_hash`=? WHERE `key_hash`=?", helpers.HashToken(newKey), helpers.HashToken(key))
+	if err1 != nil {
+		log.Error().Msgf("Failed to rotate session key: %s", err1.Error())
+		return ""
+	}
+
+	if affected, _ := res.RowsAffected(); affected != 1 {
+		return ""
+	}
+
+	return newKey
+}
+
+// DeleteSessionKey deletes session with passed key.
+func DeleteSessionKey(key string) {
+	if _, err := database.DB.Exec("DELETE FROM `sessions` WHERE `key_hash`=?", helpers.HashToken(key)); err != nil {
+		log.Error().Msgf("Failed to delete session: %s", err.Error())
+	}
+}
+
+// GetUserSessions returns user's sessions which aren't expired yet,
+// recently seen first.
+func GetUserSessions(uid int) []*Session {
+	sessions := []*Session{}
+	createdSince, seenSince := validSince()
+	err := database.DB.Select(&sessions, "SELECT * FROM `sessions` WHERE `user_id`=? AND `created_at`>? AND `last_seen_at`>? ORDER BY `last_seen_at` DESC", uid, createdSince, seenSince)
+	if err != nil {
+		log.Error().Msgf("Failed to get sessions of user #%d: %s", uid, err.Error())
+		return nil
+	}
+
+	return sessions
+}
+
+// DeleteUserSession deletes user's session with passed ID.
+func DeleteUserSession(uid int, id int) error {
+	res, err := database.DB.Exec("DELETE FROM `sessions` WHERE `id`=? AND `user_id`=?", id, uid)
+	if err != nil {
+		return err
+	}
+
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteOtherUserSessions deletes all user's sessions except one with
+// passed ID, logging user out on all other devices.
+func DeleteOtherUserSessions(uid int, keepID int) error {
+	_, err := database.DB.Exec("DELETE FROM `sessions` WHERE `user_id`=? AND `id`<>?", uid, keepID)
+	return err
+}
+
+// CountActiveSessions returns count of user's sessions which aren't
+// expired yet.
+func CountActiveSessions(uid int) int {
+	var count int
+	createdSince, seenSince := validSince()
+	err := database.DB.Get(&count, "SELECT COUNT(*) FROM `sessions` WHERE `user_id`=? AND `created_at`>? AND `last_seen_at`>?", uid, createdSince, seenSince)
+	if err != nil {
+		log.Error().Msgf("Failed to count sessions for user #%d: %s", uid, err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// CountAllActiveSessions returns count of sessions which aren't expired
+// yet.
+func CountAllActiveSessions() int {
+	var count int
+	createdSince, seenSince := validSince()
+	err := database.DB.Get(&count, "SELECT COUNT(*) FROM `sessions` WHERE `created_at`>? AND `last_seen_at`>?", createdSince, seenSince)
+	if err != nil {
+		log.Error().Msgf("Failed to count sessions: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// DeleteUserSessions deletes all user's sessions, logging user out
+// everywhere.
+func DeleteUserSessions(uid int) error {
+	_, err := database.DB.Exec("DELETE FROM `sessions` WHERE `user_id`=?", uid)
+	return err
+}
+
+// Deletes session.
+func (s *Session) delete() {
+	if _, err := database.DB.NamedExec("DELETE FROM `sessions` WHERE `id`=:id", s); err != nil {
+		log.Error().Msgf("Failed to delete session #%d: %s", s.ID, err.Error())
+	}
 }
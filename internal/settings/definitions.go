@@ -0,0 +1,547 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package settings
+
+import (
+	// stdlib
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+)
+
+// Settings groups in order they're shown.
+var Groups = []string{"Site", "Sessions", "Accounts", "Security", "LDAP", "OIDC", "Mail", "Routing", "Features", "Hooks", "Proxy", "Audit"}
+
+// Definitions of settings which might be changed thru web interface.
+// Database connection and listen address are needed before database
+// is available and can be set only in configuration file.
+var definitions = []*Setting{
+	{
+		Key:         "site.name",
+		Group:       "Site",
+		Name:        "Site name",
+		Description: "Shown in page titles, navigation and mails.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.Site.Name }),
+		def:         "MAGISTER",
+	},
+	{
+		Key:         "http.session_validity_days",
+		Group:       "Sessions",
+		Name:        "Session validity",
+		Description: "How many days user stays logged in, regardless of activity. Affects already issued sessions too.",
+		Type:        TypeInt,
+		Check:       intRange(1, 3650),
+		file:        fileInt(func() int { return config.Config.HTTP.SessionValidityDays }),
+		def:         "30",
+	},
+	{
+		Key:         "http.session_idle_hours",
+		Group:       "Sessions",
+		Name:        "Session idle timeout",
+		Description: "After how many hours without requests user is logged out. Affects already issued sessions too.",
+		Type:        TypeInt,
+		Check:       intRange(1, 87600),
+		file:        fileInt(func() int { return config.Config.HTTP.SessionIdleHours }),
+		def:         "168",
+	},
+	{
+		Key:         "registration.enabled",
+		Group:       "Accounts",
+		Name:        "Self-service registration",
+		Description: "Allow visitors to register on /register/. Registered users get viewer role.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.Registration.Enabled }),
+		def:         "false",
+	},
+	{
+		Key:         "registration.allowed_domains",
+		Group:       "Accounts",
+		Name:        "Allowed email domains",
+		Description: "Comma-separated email domains which are allowed to register, e.g. example.com. Any domain is allowed if empty.",
+		Type:        TypeList,
+		file: func() (string, bool) {
+			return strings.Join(config.Config.Registration.AllowedDomains, ","), config.Config.Registration.AllowedDomains != nil
+		},
+	},
+	{
+		Key:         "registration.verification",
+		Group:       "Accounts",
+		Name:        "Activation",
+		Description: "How registered users are activated: \"email\" - by link sent to their email, \"approval\" - by administrator on Users tab.",
+		Type:        TypeString,
+		Check:       oneOf("email", "approval"),
+		file:        fileString(func() string { return config.Config.Registration.Verification }),
+		def:         "email",
+	},
+	{
+		Key:         "registration.token_validity_hours",
+		Group:       "Accounts",
+		Name:        "Activation link validity",
+		Description: "How many hours activation link sent by email is valid.",
+		Type:        TypeInt,
+		Check:       intRange(1, 720),
+		file:        fileInt(func() int { return config.Config.Registration.TokenValidityHours }),
+		def:         "24",
+	},
+	{
+		Key:         "password_reset.token_validity_minutes",
+		Group:       "Accounts",
+		Name:        "Password reset link validity",
+		Description: "How many minutes link for forgotten password reset is valid.",
+		Type:        TypeInt,
+		Check:       intRange(5, 1440),
+		file:        fileInt(func() int { return config.Config.PasswordReset.TokenValidityMinutes }),
+		def:         "60",
+	},
+	{
+		Key:         "security.require_2fa_roles",
+		Group:       "Security",
+		Name:        "Require two-factor authentication",
+		Description: "Comma-separated roles which users must set up two-factor authentication, e.g. admin,maintainer. Such users can't do anything else until it's done.",
+		Type:        TypeList,
+		Check:       listOf("admin", "maintainer", "auditor", "viewer"),
+		file: func() (string, bool) {
+			return strings.Join(config.Config.Security.RequireTwoFactorRoles, ","), config.Config.Security.RequireTwoFactorRoles != nil
+		},
+	},
+	{
+		Key:         "security.password_hash",
+		Group:       "Security",
+		Name:        "Password hashing algorithm",
+		Description: "Algorithm for new password hashes: argon2id, scrypt or bcrypt. Existing hashes are rehashed on next successful login.",
+		Type:        TypeString,
+		Check:       oneOf("argon2id", "scrypt", "bcrypt"),
+		file:        fileString(func() string { return config.Config.Security.PasswordHash }),
+		def:         "argon2id",
+	},
+	{
+		Key:         "security.lockout_failures",
+		Group:       "Security",
+		Name:        "Failed logins before lockout",
+		Description: "How many failed logins in a row lock account. Attempts are slowed down before that.",
+		Type:        TypeInt,
+		Check:       intRange(3, 100),
+		file:        fileInt(func() int { return config.Config.Security.LockoutFailures }),
+		def:         "10",
+	},
+	{
+		Key:         "security.lockout_minutes",
+		Group:       "Security",
+		Name:        "Lockout duration",
+		Description: "How many minutes account stays locked after too many failed logins. Administrator might unlock it earlier.",
+		Type:        TypeInt,
+		Check:       intRange(1, 1440),
+		file:        fileInt(func() int { return config.Config.Security.LockoutMinutes }),
+		def:         "15",
+	},
+	{
+		Key:         "ldap.enabled",
+		Group:       "LDAP",
+		Name:        "LDAP authentication",
+		Description: "Authenticate users against LDAP or Active Directory. Users are created on first login, local users still log in with their passwords.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.LDAP.Enabled }),
+		def:         "false",
+	},
+	{
+		Key:         "ldap.url",
+		Group:       "LDAP",
+		Name:        "Server",
+		Description: "LDAP server URL, e.g. ldap://ldap.example.com:389 or ldaps://dc.example.com.",
+		Type:        TypeString,
+		Check:       ldapURL,
+		file:        fileString(func() string { return config.Config.LDAP.URL }),
+		def:         "ldap://localhost:389",
+	},
+	{
+		Key:         "ldap.start_tls",
+		Group:       "LDAP",
+		Name:        "StartTLS",
+		Description: "Upgrade ldap:// connection to TLS before sending credentials.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.LDAP.StartTLS }),
+		def:         "false",
+	},
+	{
+		Key:         "ldap.bind_dn",
+		Group:       "LDAP",
+		Name:        "Bind DN",
+		Description: "Account which is used to search for users, e.g. cn=magister,ou=services,dc=example,dc=com. Anonymous search is used if empty.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.LDAP.BindDN }),
+	},
+	{
+		Key:         "ldap.bind_password",
+		Group:       "LDAP",
+		Name:        "Bind password",
+		Description: "Leave empty to keep current password.",
+		Type:        TypeString,
+		Secret:      true,
+		file:        fileString(func() string { return config.Config.LDAP.BindPassword }),
+	},
+	{
+		Key:         "ldap.base_dn",
+		Group:       "LDAP",
+		Name:        "Base DN",
+		Description: "Where users are searched, e.g. ou=people,dc=example,dc=com.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.LDAP.BaseDN }),
+	},
+	{
+		Key:         "ldap.user_filter",
+		Group:       "LDAP",
+		Name:        "User filter",
+		Description: "Filter for user search, %s is replaced with login. Use (sAMAccountName=%s) for Active Directory.",
+		Type:        TypeString,
+		Check:       ldapFilter,
+		file:        fileString(func() string { return config.Config.LDAP.UserFilter }),
+		def:         "(uid=%s)",
+	},
+	{
+		Key:         "ldap.login_attribute",
+		Group:       "LDAP",
+		Name:        "Login attribute",
+		Description: "Attribute with login of created users.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.LDAP.LoginAttribute }),
+		def:         "uid",
+	},
+	{
+		Key:         "ldap.email_attribute",
+		Group:       "LDAP",
+		Name:        "Email attribute",
+		Description: "Attribute with email of created users.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.LDAP.EmailAttribute }),
+		def:         "mail",
+	},
+	{
+		Key:         "ldap.group_attribute",
+		Group:       "LDAP",
+		Name:        "Group attribute",
+		Description: "Attribute of user entry which lists user's groups.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.LDAP.GroupAttribute }),
+		def:         "memberOf",
+	},
+	{
+		Key:         "ldap.role_mapping",
+		Group:       "LDAP",
+		Name:        "Group to role mapping",
+		Description: "Comma-separated group:role pairs, e.g. magister-admins:admin,developers:maintainer. Group is matched by its name (CN), user gets most powerful of matched roles on every login.",
+		Type:        TypeList,
+		Check:       mappingOf("admin", "maintainer", "auditor", "viewer"),
+		file: func() (string, bool) {
+			return strings.Join(config.Config.LDAP.RoleMapping, ","), config.Config.LDAP.RoleMapping != nil
+		},
+	},
+	{
+		Key:         "ldap.default_role",
+		Group:       "LDAP",
+		Name:        "Default role",
+		Description: "Role for users which aren't in any mapped group. Such users can't log in if empty.",
+		Type:        TypeString,
+		Check:       oneOf("", "admin", "maintainer", "auditor", "viewer"),
+		file:        fileString(func() string { return config.Config.LDAP.DefaultRole }),
+	},
+	{
+		Key:         "oidc.enabled",
+		Group:       "OIDC",
+		Name:        "OpenID Connect login",
+		Description: "Show \"Sign in with ...\" button on login form. Users are created on first login, local users still log in with their passwords.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.OIDC.Enabled }),
+		def:         "false",
+	},
+	{
+		Key:         "oidc.issuer",
+		Group:       "OIDC",
+		Name:        "Issuer",
+		Description: "Identity provider's issuer URL, e.g. https://sso.example.com/realms/company. Its configuration is requested from /.well-known/openid-configuration.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.OIDC.Issuer }),
+	},
+	{
+		Key:         "oidc.client_id",
+		Group:       "OIDC",
+		Name:        "Client ID",
+		Description: "Redirect URI to register with identity provider is /login/oidc/callback/ on MAGISTER's domain.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.OIDC.ClientID }),
+	},
+	{
+		Key:         "oidc.client_secret",
+		Group:       "OIDC",
+		Name:        "Client secret",
+		Description: "Leave empty to keep current secret. Public clients don't need it, PKCE is always used.",
+		Type:        TypeString,
+		Secret:      true,
+		file:        fileString(func() string { return config.Config.OIDC.ClientSecret }),
+	},
+	{
+		Key:         "oidc.scopes",
+		Group:       "OIDC",
+		Name:        "Scopes",
+		Description: "Comma-separated scopes to request. Add scope which provides groups claim if identity provider requires it.",
+		Type:        TypeList,
+		Check:       oidcScopes,
+		file: func() (string, bool) {
+			return strings.Join(config.Config.OIDC.Scopes, ","), config.Config.OIDC.Scopes != nil
+		},
+		def: "openid,profile,email",
+	},
+	{
+		Key:         "oidc.button_label",
+		Group:       "OIDC",
+		Name:        "Button label",
+		Description: "Login button says \"Sign in with\" and this label.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.OIDC.ButtonLabel }),
+		def:         "SSO",
+	},
+	{
+		Key:         "oidc.login_claim",
+		Group:       "OIDC",
+		Name:        "Login claim",
+		Description: "ID token claim with login of created users.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.OIDC.LoginClaim }),
+		def:         "preferred_username",
+	},
+	{
+		Key:         "oidc.email_claim",
+		Group:       "OIDC",
+		Name:        "Email claim",
+		Description: "ID token claim with email of created users.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.OIDC.EmailClaim }),
+		def:         "email",
+	},
+	{
+		Key:         "oidc.groups_claim",
+		Group:       "OIDC",
+		Name:        "Groups claim",
+		Description: "ID token claim which lists user's groups.",
+		Type:        TypeString,
+		Check:       notEmpty,
+		file:        fileString(func() string { return config.Config.OIDC.GroupsClaim }),
+		def:         "groups",
+	},
+	{
+		Key:         "oidc.role_mapping",
+		Group:       "OIDC",
+		Name:        "Group to role mapping",
+		Description: "Comma-separated group:role pairs, e.g. magister-admins:admin,developers:maintainer. User gets most powerful of matched roles on every login.",
+		Type:        TypeList,
+		Check:       mappingOf("admin", "maintainer", "auditor", "viewer"),
+		file: func() (string, bool) {
+			return strings.Join(config.Config.OIDC.RoleMapping, ","), config.Config.OIDC.RoleMapping != nil
+		},
+	},
+	{
+		Key:         "oidc.default_role",
+		Group:       "OIDC",
+		Name:        "Default role",
+		Description: "Role for users which aren't in any mapped group. Such users can't log in if empty.",
+		Type:        TypeString,
+		Check:       oneOf("", "admin", "maintainer", "auditor", "viewer"),
+		file:        fileString(func() string { return config.Config.OIDC.DefaultRole }),
+	},
+	{
+		Key:         "mailsender.host",
+		Group:       "Mail",
+		Name:        "SMTP server",
+		Description: "Address of SMTP server as host:port.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.MailSender.Host }),
+		def:         "localhost:25",
+	},
+	{
+		Key:         "mailsender.user",
+		Group:       "Mail",
+		Name:        "SMTP user",
+		Description: "Leave empty if SMTP server doesn't require authorization.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.MailSender.User }),
+	},
+	{
+		Key:         "mailsender.password",
+		Group:       "Mail",
+		Name:        "SMTP password",
+		Description: "Leave empty to keep current password.",
+		Type:        TypeString,
+		Secret:      true,
+		file:        fileString(func() string { return config.Config.MailSender.Password }),
+	},
+	{
+		Key:         "mailsender.from",
+		Group:       "Mail",
+		Name:        "Sender address",
+		Description: "Address mails are sent from.",
+		Type:        TypeString,
+		file:        fileString(func() string { return config.Config.MailSender.From }),
+	},
+	{
+		Key:         "http.trusted_proxies",
+		Group:       "Routing",
+		Name:        "Trusted proxies",
+		Description: "Comma-separated addresses or CIDRs of reverse proxies which are allowed to pass client's address. Routing policies match that address.",
+		Type:        TypeList,
+		Check:       addressesList,
+		file: func() (string, bool) {
+			return strings.Join(config.Config.HTTP.TrustedProxies, ","), config.Config.HTTP.TrustedProxies != nil
+		},
+	},
+	{
+		Key:         "packages.maintenance_retry_after",
+		Group:       "Routing",
+		Name:        "Maintenance retry delay",
+		Description: "How many seconds clients should wait before retrying to get package which is under maintenance.",
+		Type:        TypeInt,
+		Check:       intRange(0, 604800),
+		file:        fileInt(func() int { return config.Config.Packages.MaintenanceRetryAfter }),
+		def:         "3600",
+	},
+	{
+		Key:         "packages.probe_minutes",
+		Group:       "Routing",
+		Name:        "Sources probe interval",
+		Description: "How often enabled sources URLs are probed, in minutes. URL which becomes unreachable or comes back is reported to outgoing webhooks.",
+		Type:        TypeInt,
+		Check:       intRange(1, 10080),
+		file:        fileInt(func() int { return config.Config.Packages.ProbeMinutes }),
+		def:         "15",
+	},
+	{
+		Key:         "features.vulndb",
+		Group:       "Features",
+		Name:        "Vulnerability database",
+		Description: "Serve imported advisories on /vulndb/.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.Features.VulnDB }),
+		def:         "true",
+	},
+	{
+		Key:         "features.inbound_hooks",
+		Group:       "Features",
+		Name:        "Inbound webhooks",
+		Description: "Accept push webhooks from git hosts on /hooks/.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.Features.InboundHooks }),
+		def:         "true",
+	},
+	{
+		Key:         "features.outgoing_webhooks",
+		Group:       "Features",
+		Name:        "Outgoing webhooks",
+		Description: "Notify other systems about registry events. Events raised while disabled are not sent later.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.Features.OutgoingWebhooks }),
+		def:         "true",
+	},
+	{
+		Key:         "features.proxy",
+		Group:       "Features",
+		Name:        "Module proxy",
+		Description: "Serve packages as module proxy (GOPROXY) on /proxy/ and keep snapshots of served versions. Requires proxy.storage_path in configuration file.",
+		Type:        TypeBool,
+		file:        fileBool(func() *bool { return config.Config.Features.Proxy }),
+		def:         "false",
+	},
+	{
+		Key:         "hooks.retention_days",
+		Group:       "Hooks",
+		Name:        "Inbound webhooks log retention",
+		Description: "How many days inbound webhooks deliveries are kept.",
+		Type:        TypeInt,
+		Check:       intRange(1, 36500),
+		file:        fileInt(func() int { return config.Config.Hooks.RetentionDays }),
+		def:         "30",
+	},
+	{
+		Key:         "proxy.retention_days",
+		Group:       "Proxy",
+		Name:        "Snapshots retention",
+		Description: "How many days files of unpinned snapshots which weren't downloaded are kept. Their checksums are kept forever, so version which is fetched again must be identical. Kept forever if 0.",
+		Type:        TypeInt,
+		Check:       intRange(0, 36500),
+		file:        fileInt(func() int { return config.Config.Proxy.RetentionDays }),
+		def:         "0",
+	},
+	{
+		Key:         "proxy.check_hours",
+		Group:       "Proxy",
+		Name:        "Upstream check interval",
+		Description: "How often versions of snapshotted modules are compared with upstream. Inbound webhooks trigger check immediately.",
+		Type:        TypeInt,
+		Check:       intRange(1, 720),
+		file:        fileInt(func() int { return config.Config.Proxy.CheckHours }),
+		def:         "24",
+	},
+	{
+		Key:         "audit.retention_days",
+		Group:       "Audit",
+		Name:        "Audit log retention",
+		Description: "How many days audit log events are kept.",
+		Type:        TypeInt,
+		Check:       intRange(1, 36500),
+		file:        fileInt(func() int { return config.Config.Audit.RetentionDays }),
+		def:         "365",
+	},
+}
+
+// Configuration file has no way to tell empty string or zero from
+// missing value, so they're treated as missing.
+func fileString(f func() string) func() (string, bool) {
+	return func() (string, bool) {
+		value := f()
+		return value, value != ""
+	}
+}
+
+func fileInt(f func() int) func() (string, bool) {
+	return func() (string, bool) {
+		value := f()
+		return strconv.Itoa(value), value != 0
+	}
+}
+
+func fileBool(f func() *bool) func() (string, bool) {
+	return func() (string, bool) {
+		value := f()
+		if value == nil {
+			return "", false
+		}
+
+		return strconv.FormatBool(*value), true
+	}
+}
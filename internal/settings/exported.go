@@ -0,0 +1,159 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package settings
+
+import (
+	// stdlib
+	"os"
+	"strconv"
+	"sync"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// Effective values. Nil until Initialize() is called, values from
+	// configuration file and environment are used until then.
+	values      map[string]*Value
+	valuesMutex sync.RWMutex
+)
+
+// Initialize loads settings. Should be called after database
+// initialization. Invalid values from configuration file are fatal,
+// invalid values from database or environment are ignored.
+func Initialize() {
+	log.Info().Msg("Initializing settings...")
+
+	for _, s := range definitions {
+		if value, ok := s.file(); ok {
+			if err := s.Validate(s.Normalize(value)); err != nil {
+				log.Fatal().Msgf("Invalid value for '%s' in configuration file: %s", s.Key, err.Error())
+			}
+		}
+	}
+
+	Reload()
+}
+
+// Reload re-reads settings overrides from database.
+func Reload() {
+	overrides := getOverrides()
+
+	newValues := make(map[string]*Value)
+	for _, s := range definitions {
+		newValues[s.Key] = resolve(s, overrides)
+	}
+
+	valuesMutex.Lock()
+	values = newValues
+	valuesMutex.Unlock()
+}
+
+// Returns effective setting value. Environment wins over database
+// which wins over configuration file.
+func resolve(s *Setting, overrides map[string]string) *Value {
+	if value, ok := os.LookupEnv(s.EnvironmentVariable()); ok {
+		value = s.Normalize(value)
+		if err := s.Validate(value); err != nil {
+			log.Error().Msgf("Invalid value for '%s' in %s environment variable, ignoring: %s", s.Key, s.EnvironmentVariable(), err.Error())
+		} else {
+			return &Value{Value: value, Source: SourceEnvironment}
+		}
+	}
+
+	if value, ok := overrides[s.Key]; ok {
+		if err := s.Validate(value); err != nil {
+			log.Error().Msgf("Invalid value for '%s' in database, ignoring: %s", s.Key, err.Error())
+		} else {
+			return &Value{Value: value, Source: SourceDatabase}
+		}
+	}
+
+	return FileValue(s)
+}
+
+// FileValue returns value from configuration file or built-in default.
+func FileValue(s *Setting) *Value {
+	if value, ok := s.file(); ok {
+		return &Value{Value: s.Normalize(value), Source: SourceFile}
+	}
+
+	return &Value{Value: s.def, Source: SourceDefault}
+}
+
+// Definitions returns all settings definitions.
+func Definitions() []*Setting {
+	return definitions
+}
+
+// GetDefinition returns setting definition by key.
+func GetDefinition(key string) *Setting {
+	for _, s := range definitions {
+		if s.Key == key {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Get returns effective value of setting with passed key.
+func Get(key string) *Value {
+	s := GetDefinition(key)
+	if s == nil {
+		log.Error().Msgf("Unknown setting '%s' requested", key)
+		return &Value{}
+	}
+
+	valuesMutex.RLock()
+	value, found := values[key]
+	valuesMutex.RUnlock()
+
+	if !found {
+		return resolve(s, nil)
+	}
+
+	return value
+}
+
+// String returns setting's value.
+func String(key string) string {
+	return Get(key).Value
+}
+
+// Int returns setting's value as integer.
+func Int(key string) int {
+	i, _ := strconv.Atoi(Get(key).Value)
+	return i
+}
+
+// Bool returns setting's value as boolean.
+func Bool(key string) bool {
+	b, _ := parseBool(Get(key).Value)
+	return b
+}
+
+// List returns setting's value as list.
+func List(key string) []string {
+	return splitList(Get(key).Value)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package settings
+
+import (
+	// stdlib
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Settings value types. All values are stored as strings, lists are
+// comma-separated.
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeBool   = "bool"
+	TypeList   = "list"
+)
+
+// Sources of effective setting's value, from lowest to highest priority.
+const (
+	// Built-in default, used if configuration file has no value.
+	SourceDefault = "default"
+	// Configuration file.
+	SourceFile = "file"
+	// Value set thru web interface.
+	SourceDatabase = "database"
+	// MAGISTER_* environment variable. Such setting can't be changed
+	// thru web interface.
+	SourceEnvironment = "environment"
+)
+
+// Setting describes single setting which might be changed thru web
+// interface.
+type Setting struct {
+	// Key, e.g. "site.name". Same as path in configuration file.
+	Key string
+	// Group for web interface.
+	Group string
+	// Human-readable name and description.
+	Name        string
+	Description string
+	Type        string
+	// Secret settings values are never shown.
+	Secret bool
+	// Additional value checks, called after type checks.
+	Check func(value string) error
+	// Returns value from configuration file, "ok" is false if
+	// configuration file doesn't have it and default should be used.
+	file func() (value string, ok bool)
+	// Built-in default.
+	def string
+}
+
+// Value is a setting's effective value.
+type Value struct {
+	Value  string
+	Source string
+}
+
+// EnvironmentVariable returns name of environment variable which
+// overrides setting, e.g. "MAGISTER_SITE_NAME".
+func (s *Setting) EnvironmentVariable() string {
+	return "MAGISTER_" + strings.ToUpper(strings.Replace(s.Key, ".", "_", -1))
+}
+
+// Normalize returns value in canonical form (e.g. trimmed, with
+// booleans as "true" or "false").
+func (s *Setting) Normalize(value string) string {
+	value = strings.TrimSpace(value)
+
+	switch s.Type {
+	case TypeBool:
+		if b, err := parseBool(value); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case TypeList:
+		return strings.Join(splitList(value), ",")
+	}
+
+	return value
+}
+
+// Validate checks passed value.
+func (s *Setting) Validate(value string) error {
+	switch s.Type {
+	case TypeInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return errors.New("should be a number")
+		}
+	case TypeBool:
+		if _, err := parseBool(value); err != nil {
+			return err
+		}
+	}
+
+	if s.Check != nil {
+		return s.Check(value)
+	}
+
+	return nil
+}
+
+// Parses boolean the way it might come from configuration file,
+// environment or HTML checkbox.
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "1", "true", "yes", "on":
+		return true, nil
+	case "", "0", "false", "no", "off":
+		return false, nil
+	}
+
+	return false, errors.New("should be true or false")
+}
+
+// Splits comma-separated list dropping empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// Returns check which allows only numbers in passed range.
+func intRange(min int, max int) func(string) error {
+	return func(value string) error {
+		i, _ := strconv.Atoi(value)
+		if i < min || i > max {
+			return errors.New("should be between " + strconv.Itoa(min) + " and " + strconv.Itoa(max))
+		}
+
+		return nil
+	}
+}
+
+// Returns check which allows only passed values.
+func oneOf(values ...string) func(string) error {
+	return func(value string) error {
+		for _, v := range values {
+			if value == v {
+				return nil
+			}
+		}
+
+		return errors.New("should be one of: " + strings.Join(values, ", "))
+	}
+}
+
+// Returns check which allows only lists of passed values.
+func listOf(values ...string) func(string) error {
+	check := oneOf(values...)
+	return func(value string) error {
+		for _, item := range splitList(value) {
+			if err := check(item); err != nil {
+				return errors.New("'" + item + "' " + err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+// Returns check which allows only lists of "key:value" pairs with
+// passed values.
+func mappingOf(values ...string) func(string) error {
+	check := oneOf(values...)
+	return func(value string) error {
+		for _, item := range splitList(value) {
+			idx := strings.LastIndex(item, ":")
+			if idx < 1 {
+				return errors.New("'" + item + "' should be in key:value form")
+			}
+
+			if err := check(item[idx+1:]); err != nil {
+				return errors.New("'" + item + "': value " + err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+// Checks that value is an LDAP URL.
+func ldapURL(value string) error {
+	if !strings.HasPrefix(value, "ldap://") && !strings.HasPrefix(value, "ldaps://") {
+		return errors.New("should start with ldap:// or ldaps://")
+	}
+
+	return nil
+}
+
+// Checks that value is an LDAP filter with login placeholder.
+func ldapFilter(value string) error {
+	if !strings.HasPrefix(value, "(") || !strings.HasSuffix(value, ")") {
+		return errors.New("should be enclosed in parentheses")
+	}
+
+	if !strings.Contains(value, "%s") {
+		return errors.New("should contain %s placeholder for login")
+	}
+
+	return nil
+}
+
+// Checks that OpenID Connect scopes include "openid".
+func oidcScopes(value string) error {
+	for _, item := range splitList(value) {
+		if item == "openid" {
+			return nil
+		}
+	}
+
+	return errors.New("should include openid")
+}
+
+// Checks that value is not empty.
+func notEmpty(value string) error {
+	if value == "" {
+		return errors.New("should not be empty")
+	}
+
+	return nil
+}
+
+// Checks that every list item is an IP address or network in CIDR
+// notation.
+func addressesList(value string) error {
+	for _, item := range splitList(value) {
+		if net.ParseIP(item) != nil {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(item); err != nil {
+			return errors.New("'" + item + "' is not an IP address or CIDR")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package settings
+
+import (
+	// stdlib
+	"errors"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Setting value overridden thru web interface.
+type override struct {
+	Key       string    `db:"key"`
+	Value     string    `db:"value"`
+	UpdatedBy int       `db:"updated_by"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Returns overrides from database as key => value map.
+func getOverrides() map[string]string {
+	overrides := make(map[string]string)
+
+	// Settings might be requested before database connection is
+	// established.
+	if database.DB == nil {
+		return overrides
+	}
+
+	rows := []*override{}
+	err := database.DB.Select(&rows, "SELECT * FROM `settings`")
+	if err != nil {
+		log.Error().Msgf("Failed to get settings from database: %s", err.Error())
+		return overrides
+	}
+
+	for _, row := range rows {
+		overrides[row.Key] = row.Value
+	}
+
+	return overrides
+}
+
+// Set validates passed value and stores it in database. Change is
+// applied immediately.
+func Set(key string, value string, uid int) error {
+	s := GetDefinition(key)
+	if s == nil {
+		return errors.New("unknown setting")
+	}
+
+	if Get(key).Source == SourceEnvironment {
+		return errors.New("set by " + s.EnvironmentVariable() + " environment variable")
+	}
+
+	value = s.Normalize(value)
+	if err := s.Validate(value); err != nil {
+		return err
+	}
+
+	o := &override{Key: key, Value: value, UpdatedBy: uid, UpdatedAt: time.Now().UTC()}
+	_, err := database.DB.NamedExec("INSERT INTO `settings` (`key`, value, updated_by, updated_at) VALUES (:key, :value, :updated_by, :updated_at) ON DUPLICATE KEY UPDATE value=VALUES(value), updated_by=VALUES(updated_by), updated_at=VALUES(updated_at)", o)
+	if err != nil {
+		return err
+	}
+
+	Reload()
+	return nil
+}
+
+// Reset deletes setting's override from database, so value from
+// configuration file is used again.
+func Reset(key string) error {
+	_, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `settings` WHERE `key`=?"), key)
+	if err != nil {
+		return err
+	}
+
+	Reload()
+	return nil
+}
@@ -31,7 +31,7 @@ import (
 	// local
 	"github.com/welltrainedfolks/magister/assets/compiled"
 	"github.com/welltrainedfolks/magister/common"
-	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/settings"
 
 	// other
 	"github.com/labstack/echo"
@@ -45,6 +45,7 @@ var (
 	// below.
 	// Currently these strings are registered:
 	// - {user.name}
+	// - {packages.catalog}
 	actions map[string]func(ec echo.Context) string
 )
 
@@ -88,9 +89,12 @@ func GetRawTemplate(ec echo.Context, templateName string, data map[string]string
 
 	tpl := string(tplRaw)
 
-	// Replace data with data returned by actions functions.
+	// Replace data with data returned by actions functions. Actions
+	// might be expensive, so they're called only if template uses them.
 	for key, handler := range actions {
-		tpl = strings.Replace(tpl, "{"+key+"}", handler(ec), -1)
+		if strings.Contains(tpl, "{"+key+"}") {
+			tpl = strings.Replace(tpl, "{"+key+"}", handler(ec), -1)
+		}
 	}
 
 	// Replace placeholders with data from data map.
@@ -98,8 +102,10 @@ func GetRawTemplate(ec echo.Context, templateName string, data map[string]string
 		tpl = strings.Replace(tpl, "{"+placeholder+"}", value, -1)
 	}
 
-	// CSRF.
-	tpl = strings.Replace(tpl, "{csrf_token}", ec.Get("CSRFTOKEN").(string), -1)
+	// CSRF. Token is absent for endpoints which are exempt from CSRF
+	// checks.
+	csrfToken, _ := ec.Get("CSRFTOKEN").(string)
+	tpl = strings.Replace(tpl, "{csrf_token}", csrfToken, -1)
 
 	return tpl
 }
@@ -178,15 +184,18 @@ func GetTemplate(ec echo.Context, name string, data map[string]string) string {
 	}
 
 	// Replace basic things.
-	tpl = strings.Replace(tpl, "{site.name}", config.Config.Site.Name, -1)
+	tpl = strings.Replace(tpl, "{site.name}", settings.String("site.name"), -1)
 	tpl = strings.Replace(tpl, "{loginBar}", string(loginBarHTML), -1)
 
 	// Replace documentBody.
 	tpl = strings.Replace(tpl, "{documentBody}", string(reqhtml), 1)
 
-	// Replace data with data returned by actions functions.
+	// Replace data with data returned by actions functions. Actions
+	// might be expensive, so they're called only if template uses them.
 	for key, handler := range actions {
-		tpl = strings.Replace(tpl, "{"+key+"}", handler(ec), -1)
+		if strings.Contains(tpl, "{"+key+"}") {
+			tpl = strings.Replace(tpl, "{"+key+"}", handler(ec), -1)
+		}
 	}
 
 	// Replace placeholders with data from data map.
@@ -194,8 +203,10 @@ func GetTemplate(ec echo.Context, name string, data map[string]string) string {
 		tpl = strings.Replace(tpl, "{"+placeholder+"}", value, -1)
 	}
 
-	// CSRF.
-	tpl = strings.Replace(tpl, "{csrf_token}", ec.Get("CSRFTOKEN").(string), -1)
+	// CSRF. Token is absent for endpoints which are exempt from CSRF
+	// checks.
+	csrfToken, _ := ec.Get("CSRFTOKEN").(string)
+	tpl = strings.Replace(tpl, "{csrf_token}", csrfToken, -1)
 
 	return tpl
 }
@@ -210,7 +221,7 @@ func GetTextTemplate(templateName string, data map[string]string) string {
 	}
 
 	// Replace basic variables.
-	tpl := strings.Replace(string(tplRaw), "{site.name}", config.Config.Site.Name, -1)
+	tpl := strings.Replace(string(tplRaw), "{site.name}", settings.String("site.name"), -1)
 	tpl = strings.Replace(tpl, "{code.version}", common.VERSION, 1)
 	tpl = strings.Replace(tpl, "{code.build}", strconv.Itoa(common.BUILD), 1)
 	tpl = strings.Replace(tpl, "{code.build_date}", common.BUILDDATE, 1)
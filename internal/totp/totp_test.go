@@ -0,0 +1,108 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package totp
+
+import (
+	// stdlib
+	"testing"
+	"time"
+)
+
+// Secret of RFC 6238 test vectors, "12345678901234567890" in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 appendix B test vectors for SHA1. RFC lists 8-digit codes,
+// 6-digit codes are their last 6 digits.
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func TestCodeRFC6238(t *testing.T) {
+	for _, v := range rfcVectors {
+		code, err := Code(rfcSecret, Step(time.Unix(v.unix, 0)))
+		if err != nil {
+			t.Fatalf("Failed to generate code for %d: %s", v.unix, err.Error())
+		}
+
+		if code != v.code {
+			t.Errorf("Expected code %s for %d, got %s", v.code, v.unix, code)
+		}
+	}
+}
+
+func TestCodeLowercaseSecret(t *testing.T) {
+	code, err := Code("gezdgnbvgy3tqojqgezdgnbvgy3tqojq", Step(time.Unix(59, 0)))
+	if err != nil || code != "287082" {
+		t.Errorf("Expected code 287082 for lowercase secret, got %s (%v)", code, err)
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Error("Expected invalid secret to be rejected")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+
+	step, ok := Validate(rfcSecret, "050471", now, 0)
+	if !ok || step != current {
+		t.Errorf("Expected current code to be accepted at step %d, got %d (%v)", current, step, ok)
+	}
+
+	if _, ok := Validate(rfcSecret, " 050 471 ", now, 0); !ok {
+		t.Error("Expected code with spaces to be accepted")
+	}
+
+	// 1111111109 belongs to previous step, which is within skew.
+	if step, ok := Validate(rfcSecret, "081804", now, 0); !ok || step != current-1 {
+		t.Errorf("Expected previous step's code to be accepted at step %d, got %d (%v)", current-1, step, ok)
+	}
+
+	if _, ok := Validate(rfcSecret, "050471", now, current); ok {
+		t.Error("Expected already used code to be rejected")
+	}
+
+	if _, ok := Validate(rfcSecret, "081804", now, current-1); ok {
+		t.Error("Expected code of already used step to be rejected")
+	}
+
+	if _, ok := Validate(rfcSecret, "005924", now, 0); ok {
+		t.Error("Expected code of distant step to be rejected")
+	}
+
+	for _, code := range []string{"", "05047", "0504711", "abcdef"} {
+		if _, ok := Validate(rfcSecret, code, now, 0); ok {
+			t.Errorf("Expected code %q to be rejected", code)
+		}
+	}
+}
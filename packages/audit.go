@@ -0,0 +1,43 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+// AuditData returns package's fields which are written into audit log.
+func (p *Package) AuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         p.Name,
+		"import_path":  p.OriginalPackageURL,
+		"state":        p.State,
+		"state_reason": p.StateReason,
+	}
+}
+
+// AuditData returns sources URL's fields which are written into audit
+// log.
+func (u *URL) AuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"url":     u.URL,
+		"vcs":     u.VCS,
+		"mirror":  u.Mirror,
+		"enabled": u.Enabled,
+	}
+}
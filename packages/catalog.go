@@ -0,0 +1,67 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"html"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns HTML for packages catalog which is shown on index page.
+// Archived packages are hidden, and drafts are shown only to their
+// owners and to users who manage packages.
+func getCatalog(ec echo.Context) string {
+	uid := currentUID(ec)
+
+	var catalog string
+	for _, pkg := range GetPackages() {
+		if pkg.State == StateArchived || !pkg.IsVisibleTo(uid) {
+			continue
+		}
+
+		var tag string
+		switch pkg.State {
+		case StateDraft:
+			tag = `<span class="tag is-light">draft</span>`
+		case StateMaintenance:
+			tag = `<span class="tag is-warning">maintenance</span>`
+		}
+
+		catalog += templater.GetRawTemplate(ec, "packages/catalog_item.html", map[string]string{
+			"package.import_path": html.EscapeString(pkg.OriginalPackageURL),
+			"package.name":        html.EscapeString(pkg.Name),
+			"package.tag":         tag,
+		})
+	}
+
+	if catalog == "" {
+		catalog = templater.GetRawTemplate(ec, "packages/catalog_empty.html", nil)
+	}
+
+	return catalog
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// other
+	"github.com/labstack/echo"
+)
+
+// DependenciesRenderer renders dependencies between package's modules
+// and other packages for package's page. Dependencies are known only
+// to module proxy, which imports this package, so it's set from there.
+type DependenciesRenderer func(ec echo.Context, pkg *Package, uid int) string
+
+var dependenciesRenderer DependenciesRenderer
+
+// SetDependenciesRenderer sets function which renders package's
+// dependencies on package's page.
+func SetDependenciesRenderer(f DependenciesRenderer) {
+	dependenciesRenderer = f
+}
+
+// Returns package's dependencies for package's page.
+func packageDependencies(ec echo.Context, pkg *Package) string {
+	if dependenciesRenderer == nil {
+		return ""
+	}
+
+	return dependenciesRenderer(ec, pkg, currentUID(ec))
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/webhooks"
+)
+
+// EventData is package's data which is sent to outgoing webhooks.
+type EventData struct {
+	ImportPath  string     `json:"import_path"`
+	Name        string     `json:"name"`
+	State       string     `json:"state"`
+	StateReason string     `json:"state_reason,omitempty"`
+	URLs        []EventURL `json:"urls"`
+	// For package.state_changed event.
+	PreviousState string `json:"previous_state,omitempty"`
+	// For package.pushed and package.tagged events.
+	Provider string `json:"provider,omitempty"`
+	Ref      string `json:"ref,omitempty"`
+	// For package.tagged event, tag name, for package.version_*
+	// events - module's version.
+	Version string `json:"version,omitempty"`
+	// For package.version_* events, module path (package might contain
+	// several modules) and what differs from upstream.
+	Module      string `json:"module,omitempty"`
+	Discrepancy string `json:"discrepancy,omitempty"`
+	// For package.mirror_* events, probed sources URL and probe's
+	// result.
+	Mirror      *EventURL `json:"mirror,omitempty"`
+	ProbeResult string    `json:"probe_result,omitempty"`
+}
+
+// EventURL is package's sources URL in events data.
+type EventURL struct {
+	URL     string `json:"url"`
+	VCS     string `json:"vcs"`
+	Mirror  string `json:"mirror,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Returns package's data for events.
+func (p *Package) eventData() *EventData {
+	data := &EventData{
+		ImportPath:  p.OriginalPackageURL,
+		Name:        p.Name,
+		State:       p.State,
+		StateReason: p.StateReason,
+		URLs:        []EventURL{},
+	}
+
+	for _, url := range p.GetURLs() {
+		data.URLs = append(data.URLs, EventURL{URL: url.URL, VCS: url.VCS, Mirror: url.Mirror, Enabled: url.Enabled})
+	}
+
+	return data
+}
+
+// Emit sends package's event to outgoing webhooks.
+func (p *Package) Emit(event string) {
+	webhooks.Emit(event, p.eventData())
+}
+
+// EmitPush sends event about push into package's repository reported
+// by git host. Pushed tag means new version and is sent as
+// package.tagged.
+func (p *Package) EmitPush(provider string, ref string) {
+	data := p.eventData()
+	data.Provider = provider
+	data.Ref = ref
+
+	event := webhooks.EventPackagePushed
+	if strings.HasPrefix(ref, "refs/tags/") {
+		event = webhooks.EventPackageTagged
+		data.Version = strings.TrimPrefix(ref, "refs/tags/")
+	}
+
+	webhooks.Emit(event, data)
+}
+
+// EmitVersion sends event about version of package's module which
+// module proxy snapshotted. Discrepancy is empty for new versions.
+func (p *Package) EmitVersion(event string, module string, version string, discrepancy string) {
+	data := p.eventData()
+	data.Module = module
+	data.Version = version
+	data.Discrepancy = discrepancy
+
+	webhooks.Emit(event, data)
+}
+
+// EmitMirror sends event about sources URL which became unreachable or
+// came back.
+func (p *Package) EmitMirror(event string, url *URL, res *ProbeResult) {
+	data := p.eventData()
+	data.Mirror = &EventURL{URL: url.URL, VCS: url.VCS, Mirror: url.Mirror, Enabled: url.Enabled}
+	data.ProbeResult = res.Result
+
+	webhooks.Emit(event, data)
+}
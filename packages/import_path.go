@@ -0,0 +1,168 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/vulns"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Default value for Retry-After header for packages under maintenance.
+const defaultMaintenanceRetryAfter = 3600
+
+// Returns ID of currently logged in user or zero if user isn't logged
+// in.
+func currentUID(ec echo.Context) int {
+	if !ec.Get("AUTHORIZED").(bool) {
+		return 0
+	}
+
+	return ec.Get("UID").(int)
+}
+
+// Returns host current request was made to, without port.
+func requestHost(ec echo.Context) string {
+	host := ec.Request().Host
+	if idx := strings.LastIndex(host, ":"); idx != -1 {
+		host = host[:idx]
+	}
+
+	return host
+}
+
+// Returns import path for current request.
+func requestImportPath(ec echo.Context) string {
+	return requestHost(ec) + strings.TrimSuffix(ec.Request().URL.Path, "/")
+}
+
+// importPathGET answers both "go get" (with go-import meta tag) and
+// browsers (with package's page).
+func importPathGET(ec echo.Context) error {
+	importPath := requestImportPath(ec)
+
+	if ec.QueryParam("go-get") == "1" {
+		return goGetResponse(ec, importPath)
+	}
+
+	pkg := FindPackageForImportPath(importPath)
+	if pkg == nil || !pkg.IsVisibleTo(currentUID(ec)) {
+		log.Debug().Msgf("No package found for import path '%s'", importPath)
+		return h.NotFoundGET(ec)
+	}
+
+	return packagePage(ec, pkg)
+}
+
+// Responds to "go get".
+func goGetResponse(ec echo.Context, importPath string) error {
+	res := Resolve(ec, importPath, h.ClientIP(ec.Request()), requestHost(ec), currentUID(ec))
+
+	switch res.Status {
+	case http.StatusOK:
+		return ec.HTML(http.StatusOK, res.Body)
+	case http.StatusServiceUnavailable:
+		ec.Response().Header().Set("Retry-After", strconv.Itoa(res.RetryAfter))
+		return ec.String(http.StatusServiceUnavailable, res.Body)
+	}
+
+	if res.Package == nil || res.Hidden {
+		log.Debug().Msgf("No package found for import path '%s'", importPath)
+		recordNotFound(importPath)
+	} else {
+		log.Warn().Msgf("Package '%s' has no enabled sources URLs", res.Package.OriginalPackageURL)
+	}
+
+	return h.NotFoundGET(ec)
+}
+
+// Shows package's page.
+func packagePage(ec echo.Context, pkg *Package) error {
+	data := map[string]string{
+		"package.name":         html.EscapeString(pkg.Name),
+		"package.import_path":  html.EscapeString(pkg.OriginalPackageURL),
+		"package.state":        pkg.State,
+		"package.notice":       "",
+		"package.vulns":        packageVulnerabilities(ec, pkg),
+		"package.dependencies": packageDependencies(ec, pkg),
+	}
+
+	var notice string
+	switch pkg.State {
+	case StateDraft:
+		notice = "This package is a draft and visible only to its owner and to users who manage packages."
+	case StateMaintenance:
+		notice = "This package is under maintenance and can't be obtained with <code>go get</code> right now. Please try again later."
+	case StateArchived:
+		notice = "This package is archived and isn't maintained anymore."
+	}
+
+	if notice != "" {
+		if pkg.StateReason != "" {
+			notice += "<br>Reason: " + html.EscapeString(pkg.StateReason)
+		}
+		data["package.notice"] = templater.GetRawTemplate(ec, "packages/notice.html", map[string]string{"notice": notice})
+	}
+
+	tpl := templater.GetTemplate(ec, "packages/package.html", data)
+
+	return ec.HTML(http.StatusOK, tpl)
+}
+
+// Returns warning about known vulnerabilities which affect package's
+// served versions.
+func packageVulnerabilities(ec echo.Context, pkg *Package) string {
+	var items string
+	for _, m := range pkg.ServedVersions() {
+		for _, entry := range vulns.AffectingVersions(m.Module, m.Versions) {
+			var aliases string
+			if len(entry.Aliases) != 0 {
+				aliases = " (" + html.EscapeString(strings.Join(entry.Aliases, ", ")) + ")"
+			}
+
+			items += templater.GetRawTemplate(ec, "packages/vulnerability.html", map[string]string{
+				"vulnerability.id":       html.EscapeString(entry.ID),
+				"vulnerability.aliases":  aliases,
+				"vulnerability.summary":  html.EscapeString(entry.Summary),
+				"vulnerability.module":   html.EscapeString(m.Module),
+				"vulnerability.affected": html.EscapeString(entry.DescribeAffected(m.Module, m.Versions)),
+			})
+		}
+	}
+
+	if items == "" {
+		return ""
+	}
+
+	return templater.GetRawTemplate(ec, "packages/vulnerabilities.html", map[string]string{"vulnerabilities": items})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"sort"
+	"sync"
+	"time"
+)
+
+// How many distinct not found import paths are remembered.
+const notFoundLimit = 50
+
+// NotFound describes import path which "go get" requested, but which
+// isn't served.
+type NotFound struct {
+	ImportPath string    `json:"import_path"`
+	Count      int       `json:"count"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+var (
+	notFound      = make(map[string]*NotFound)
+	notFoundMutex sync.Mutex
+)
+
+// Remembers that "go get" requested import path which isn't served.
+// Only latest notFoundLimit import paths are kept, in memory.
+func recordNotFound(importPath string) {
+	notFoundMutex.Lock()
+	defer notFoundMutex.Unlock()
+
+	nf, found := notFound[importPath]
+	if !found {
+		if len(notFound) >= notFoundLimit {
+			var oldest *NotFound
+			for _, item := range notFound {
+				if oldest == nil || item.LastSeenAt.Before(oldest.LastSeenAt) {
+					oldest = item
+				}
+			}
+			delete(notFound, oldest.ImportPath)
+		}
+
+		nf = &NotFound{ImportPath: importPath}
+		notFound[importPath] = nf
+	}
+
+	nf.Count++
+	nf.LastSeenAt = time.Now().UTC()
+}
+
+// RecentNotFound returns import paths which "go get" requested, but
+// which aren't served, most recent first.
+func RecentNotFound(limit int) []NotFound {
+	notFoundMutex.Lock()
+	items := make([]NotFound, 0, len(notFound))
+	for _, item := range notFound {
+		items = append(items, *item)
+	}
+	notFoundMutex.Unlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastSeenAt.After(items[j].LastSeenAt)
+	})
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items
+}
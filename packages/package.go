@@ -0,0 +1,375 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"errors"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Package represents single package served by MAGISTER.
+type Package struct {
+	ID                 int       `db:"id"`
+	Name               string    `db:"name"`
+	OriginalPackageURL string    `db:"original_package_url"`
+	State              string    `db:"state"`
+	StateReason        string    `db:"state_reason"`
+	OwnerID            int       `db:"owner_id"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+// GetPackages returns all packages from database.
+func GetPackages() []*Package {
+	pkgs := []*Package{}
+	err := database.DB.Select(&pkgs, "SELECT * FROM `packages` ORDER BY `original_package_url`")
+	if err != nil {
+		log.Error().Msgf("Failed to get packages list: %s", err.Error())
+		return nil
+	}
+
+	return pkgs
+}
+
+// SearchPackages returns page of packages which name or original
+// package URL contains passed query. Empty query matches everything.
+func SearchPackages(query string, offset int, limit int) []*Package {
+	pkgs := []*Package{}
+	like := "%" + database.EscapeLike(query) + "%"
+	err := database.DB.Select(&pkgs, database.DB.Rebind("SELECT * FROM `packages` WHERE name LIKE ? OR original_package_url LIKE ? ORDER BY `original_package_url` LIMIT ? OFFSET ?"), like, like, limit, offset)
+	if err != nil {
+		log.Error().Msgf("Failed to search packages: %s", err.Error())
+		return nil
+	}
+
+	return pkgs
+}
+
+// CountPackages returns count of packages which name or original
+// package URL contains passed query.
+func CountPackages(query string) int {
+	var count int
+	like := "%" + database.EscapeLike(query) + "%"
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `packages` WHERE name LIKE ? OR original_package_url LIKE ?"), like, like)
+	if err != nil {
+		log.Error().Msgf("Failed to count packages: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// CountPackagesByState returns packages count for every state.
+func CountPackagesByState() map[string]int {
+	rows := []struct {
+		State string `db:"state"`
+		Count int    `db:"count"`
+	}{}
+	err := database.DB.Select(&rows, "SELECT state, COUNT(*) AS count FROM `packages` GROUP BY state")
+	if err != nil {
+		log.Error().Msgf("Failed to count packages by state: %s", err.Error())
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+
+	return counts
+}
+
+// GetPackageByID returns package by ID.
+func GetPackageByID(id int) *Package {
+	pkg := &Package{}
+	err := database.DB.Get(pkg, database.DB.Rebind("SELECT * FROM `packages` WHERE id=?"), id)
+	if err != nil {
+		log.Error().Msgf("Failed to get package with id '%d': %s", id, err.Error())
+		return nil
+	}
+
+	return pkg
+}
+
+// GetPackageByOriginalURL returns package by it's original package URL
+// (the one which is used in import lines).
+func GetPackageByOriginalURL(url string) *Package {
+	pkg := &Package{}
+	err := database.DB.Get(pkg, database.DB.Rebind("SELECT * FROM `packages` WHERE original_package_url=?"), url)
+	if err != nil {
+		log.Debug().Msgf("Failed to get package with original URL '%s': %s", url, err.Error())
+		return nil
+	}
+
+	return pkg
+}
+
+// FindPackageForImportPath returns package which serves passed import
+// path. Import path might point to package's subdirectory, so longest
+// matching package's original URL wins.
+func FindPackageForImportPath(importPath string) *Package {
+	importPath = strings.Trim(importPath, "/")
+	for importPath != "" {
+		pkg := GetPackageByOriginalURL(importPath)
+		if pkg != nil {
+			return pkg
+		}
+
+		idx := strings.LastIndex(importPath, "/")
+		if idx == -1 {
+			break
+		}
+		importPath = importPath[:idx]
+	}
+
+	return nil
+}
+
+// NewPackage creates package in database.
+func NewPackage(name, originalURL string) *Package {
+	p := &Package{}
+	p.Name = name
+	p.OriginalPackageURL = originalURL
+	p.State = StatePublished
+	p.CreatedAt = time.Now().UTC()
+	p.UpdatedAt = time.Now().UTC()
+
+	if err := p.Create(); err != nil {
+		log.Error().Msgf("Failed to create new package: %s", err.Error())
+		return nil
+	}
+
+	return p
+}
+
+// Create inserts package into database as is. Unlike NewPackage it
+// does not set timestamps, which is useful when package data comes
+// from somewhere else (e.g. from registry dump).
+func (p *Package) Create() error {
+	if p.State == "" {
+		p.State = StatePublished
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `packages` (name, original_package_url, state, state_reason, owner_id, created_at, updated_at) VALUES (:name, :original_package_url, :state, :state_reason, :owner_id, :created_at, :updated_at)", p)
+	if err != nil {
+		return err
+	}
+
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+
+	p.ID = int(lastInsertedID)
+	return nil
+}
+
+// Delete deletes package and all it's URLs from database.
+func (p *Package) Delete() error {
+	// URLs are gone after deletion.
+	data := p.eventData()
+
+	if err := p.DeleteURLs(); err != nil {
+		return err
+	}
+
+	if _, err := database.DB.NamedExec("DELETE FROM `packages` WHERE id=:id", p); err != nil {
+		return err
+	}
+
+	webhooks.Emit(webhooks.EventPackageDeleted, data)
+	return nil
+}
+
+// NormalizeImportPath removes scheme, surrounding spaces and slashes
+// from import path.
+func NormalizeImportPath(importPath string) string {
+	importPath = strings.TrimSpace(importPath)
+	if idx := strings.Index(importPath, "://"); idx != -1 {
+		importPath = importPath[idx+3:]
+	}
+
+	return strings.Trim(importPath, "/")
+}
+
+// Validate checks package's data. Original package URL should be
+// unique.
+func (p *Package) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errors.New("name should not be empty")
+	}
+
+	if p.OriginalPackageURL == "" || strings.ContainsAny(p.OriginalPackageURL, " \t\"'<>") {
+		return errors.New("import path should not be empty or contain spaces or quotes")
+	}
+
+	host := strings.Split(p.OriginalPackageURL, "/")[0]
+	if !strings.Contains(host, ".") {
+		return errors.New("import path should start with host, e.g. go.example.com/pkg")
+	}
+
+	existing := GetPackageByOriginalURL(p.OriginalPackageURL)
+	if existing != nil && existing.ID != p.ID {
+		return errors.New("package with import path '" + p.OriginalPackageURL + "' already exists")
+	}
+
+	return nil
+}
+
+// Save saves package.
+func (p *Package) Save() error {
+	p.UpdatedAt = time.Now().UTC()
+	_, err := database.DB.NamedExec("UPDATE `packages` SET name=:name, original_package_url=:original_package_url, updated_at=:updated_at WHERE id=:id", p)
+	return err
+}
+
+// Replace writes whole package: creates it (if it has no ID yet) or
+// updates it's data and state, and replaces it's sources URLs and own
+// routing policies with passed ones. Everything is checked first and
+// written in single transaction, so failure doesn't leave package half
+// updated. It is used when package comes from somewhere else (e.g. from
+// registry dump). Changed state of existing package is recorded as
+// transition made by user with passed ID (zero means magisterctl) for
+// passed reason.
+func (p *Package) Replace(urls []*URL, policies []*RoutingPolicy, uid int, reason string) error {
+	if p.State == "" {
+		p.State = StatePublished
+	}
+
+	if !IsValidState(p.State) {
+		return errors.New("unknown package state '" + p.State + "'")
+	}
+
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	for _, url := range urls {
+		if url.VCS == "" {
+			url.VCS = "git"
+		}
+
+		if err := ValidateURL(url.URL, url.VCS); err != nil {
+			return err
+		}
+	}
+
+	for _, rp := range policies {
+		if err := rp.Validate(); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now().UTC()
+	p.UpdatedAt = now
+
+	tx, err := database.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	var previousState string
+	if p.ID == 0 {
+		res, err1 := tx.NamedExec("INSERT INTO `packages` (name, original_package_url, state, state_reason, owner_id, created_at, updated_at) VALUES (:name, :original_package_url, :state, :state_reason, :owner_id, :created_at, :updated_at)", p)
+		if err1 != nil {
+			tx.Rollback()
+			return err1
+		}
+
+		lastInsertedID, err2 := res.LastInsertId()
+		if err2 != nil {
+			tx.Rollback()
+			return err2
+		}
+		p.ID = int(lastInsertedID)
+	} else {
+		if err1 := tx.Get(&previousState, tx.Rebind("SELECT state FROM `packages` WHERE id=? FOR UPDATE"), p.ID); err1 != nil {
+			tx.Rollback()
+			return err1
+		}
+
+		if _, err2 := tx.NamedExec("UPDATE `packages` SET name=:name, original_package_url=:original_package_url, state=:state, state_reason=:state_reason, updated_at=:updated_at WHERE id=:id", p); err2 != nil {
+			tx.Rollback()
+			return err2
+		}
+
+		if previousState != p.State {
+			sc := &StateChange{PackageID: p.ID, FromState: previousState, ToState: p.State, ChangedBy: uid, Reason: reason, CreatedAt: now}
+			if _, err3 := tx.NamedExec("INSERT INTO `packages_states_changes` (package_id, from_state, to_state, changed_by, reason, created_at) VALUES (:package_id, :from_state, :to_state, :changed_by, :reason, :created_at)", sc); err3 != nil {
+				tx.Rollback()
+				return err3
+			}
+		}
+
+		if _, err4 := tx.Exec(tx.Rebind("DELETE FROM `packages_urls` WHERE package_id=?"), p.ID); err4 != nil {
+			tx.Rollback()
+			return err4
+		}
+
+		if _, err5 := tx.Exec(tx.Rebind("DELETE FROM `routing_policies` WHERE package_id=?"), p.ID); err5 != nil {
+			tx.Rollback()
+			return err5
+		}
+	}
+
+	for i, url := range urls {
+		url.PackageID = p.ID
+		url.Position = i + 1
+		if _, err6 := tx.NamedExec("INSERT INTO `packages_urls` (package_id, url, vcs, mirror, enabled, position) VALUES (:package_id, :url, :vcs, :mirror, :enabled, :position)", url); err6 != nil {
+			tx.Rollback()
+			return err6
+		}
+	}
+
+	for _, rp := range policies {
+		rp.PackageID = p.ID
+		if rp.CreatedAt.IsZero() {
+			rp.CreatedAt = now
+		}
+
+		if _, err7 := tx.NamedExec("INSERT INTO `routing_policies` (package_id, priority, match_type, match_value, mirror, enabled, created_at) VALUES (:package_id, :priority, :match_type, :match_value, :mirror, :enabled, :created_at)", rp); err7 != nil {
+			tx.Rollback()
+			return err7
+		}
+	}
+
+	if err8 := tx.Commit(); err8 != nil {
+		return err8
+	}
+
+	if previousState != "" && previousState != p.State {
+		data := p.eventData()
+		data.PreviousState = previousState
+		webhooks.Emit(webhooks.EventPackageStateChanged, data)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"net"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Timeout for reachability probes.
+const probeTimeout = 10 * time.Second
+
+// How often it's checked whether sources URLs should be probed.
+const probeCheckInterval = time.Minute
+
+// Default ports for schemes which aren't probed over HTTP.
+var probePorts = map[string]string{
+	"bzr+ssh": "22",
+	"git":     "9418",
+	"git+ssh": "22",
+	"ssh":     "22",
+	"svn":     "3690",
+	"svn+ssh": "22",
+}
+
+// ProbeResult describes result of sources URL reachability probe.
+type ProbeResult struct {
+	// True if upstream answered.
+	Reachable bool
+	// What was done, e.g. "GET https://example.com/repo/info/refs".
+	Method string
+	// Human-readable result, e.g. HTTP status or error.
+	Result string
+	// How long probe took.
+	Latency time.Duration
+}
+
+// Probe checks if sources URL is reachable. HTTP(S) URLs are requested
+// the way VCS client would start cloning, for other schemes only TCP
+// connection is made.
+func (u *URL) Probe() *ProbeResult {
+	res := &ProbeResult{}
+
+	parsed, err := neturl.Parse(u.URL)
+	if err != nil || parsed.Host == "" {
+		res.Result = "cannot parse URL"
+		return res
+	}
+
+	start := time.Now()
+	defer func() {
+		res.Latency = time.Since(start)
+	}()
+
+	if parsed.Scheme == "http" || parsed.Scheme == "https" {
+		probeHTTP(res, u.VCS, parsed)
+		return res
+	}
+
+	port, known := probePorts[parsed.Scheme]
+	if !known {
+		res.Result = "don't know how to probe '" + parsed.Scheme + "' URLs"
+		return res
+	}
+
+	if parsed.Port() != "" {
+		port = parsed.Port()
+	}
+
+	address := net.JoinHostPort(parsed.Hostname(), port)
+	res.Method = "TCP connect to " + address
+
+	conn, err1 := net.DialTimeout("tcp", address, probeTimeout)
+	if err1 != nil {
+		res.Result = err1.Error()
+		return res
+	}
+	conn.Close()
+
+	res.Reachable = true
+	res.Result = "connected"
+	return res
+}
+
+// Probes HTTP(S) URL.
+func probeHTTP(res *ProbeResult, vcs string, parsed *neturl.URL) {
+	target := *parsed
+	switch vcs {
+	case "git":
+		target.Path = strings.TrimSuffix(target.Path, "/") + "/info/refs"
+		target.RawQuery = "service=git-upload-pack"
+	case "hg":
+		target.RawQuery = "cmd=capabilities"
+	}
+
+	res.Method = "GET " + target.String()
+
+	client := &http.Client{Timeout: probeTimeout}
+	resp, err := client.Get(target.String())
+	if err != nil {
+		res.Result = err.Error()
+		return
+	}
+	resp.Body.Close()
+
+	res.Reachable = true
+	res.Result = "HTTP " + strconv.Itoa(resp.StatusCode) + " " + http.StatusText(resp.StatusCode)
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		res.Result += ", repository requires authentication"
+	case resp.StatusCode >= 400:
+		res.Reachable = false
+	}
+}
+
+// Probes enabled sources URLs which weren't probed during probe
+// interval.
+func probeURLs() {
+	minutes := settings.Int("packages.probe_minutes")
+	if minutes <= 0 {
+		return
+	}
+
+	for _, u := range getURLsProbedBefore(time.Now().UTC().Add(-time.Duration(minutes) * time.Minute)) {
+		u.probeAndRecord()
+	}
+}
+
+// Returns enabled sources URLs which weren't probed since passed time.
+func getURLsProbedBefore(before time.Time) []*URL {
+	urls := []*URL{}
+	err := database.DB.Select(&urls, database.DB.Rebind("SELECT * FROM `packages_urls` WHERE enabled=1 AND (probed_at IS NULL OR probed_at<?) ORDER BY id"), before)
+	if err != nil {
+		log.Error().Msgf("Failed to get sources URLs to probe: %s", err.Error())
+		return nil
+	}
+
+	return urls
+}
+
+// Probes sources URL, records result and notifies outgoing webhooks if
+// URL became unreachable or came back. First probe only records result,
+// there is nothing to compare it with.
+func (u *URL) probeAndRecord() {
+	wasReachable := u.Reachable
+
+	res := u.Probe()
+	if err := u.saveProbe(res); err != nil {
+		log.Error().Msgf("Failed to save probe result of sources URL #%d: %s", u.ID, err.Error())
+		return
+	}
+
+	if wasReachable == nil || *wasReachable == res.Reachable {
+		return
+	}
+
+	pkg := GetPackageByID(u.PackageID)
+	if pkg == nil {
+		return
+	}
+
+	event := webhooks.EventPackageMirrorDown
+	if res.Reachable {
+		event = webhooks.EventPackageMirrorUp
+		log.Info().Msgf("Sources URL '%s' of package '%s' is reachable again", u.URL, pkg.OriginalPackageURL)
+	} else {
+		log.Warn().Msgf("Sources URL '%s' of package '%s' became unreachable: %s", u.URL, pkg.OriginalPackageURL, res.Result)
+	}
+
+	pkg.EmitMirror(event, u, res)
+}
+
+// Records probe's result. Last error is kept after URL comes back, so
+// it's known why it was down.
+func (u *URL) saveProbe(res *ProbeResult) error {
+	now := time.Now().UTC()
+	reachable := res.Reachable
+	u.Reachable = &reachable
+	u.ProbedAt = &now
+
+	if reachable {
+		u.LastSuccessAt = &now
+	} else {
+		u.LastError = res.Result
+		if len(u.LastError) > 1024 {
+			u.LastError = u.LastError[:1021] + "..."
+		}
+	}
+
+	_, err := database.DB.NamedExec("UPDATE `packages_urls` SET reachable=:reachable, probed_at=:probed_at, last_success_at=:last_success_at, last_error=:last_error WHERE id=:id", u)
+	return err
+}
+
+// ProbesStats summarizes last scheduled probes of enabled sources URLs.
+type ProbesStats struct {
+	Reachable int
+	Failing   int
+	NotProbed int
+	// When any URL was probed last time and was reachable last time.
+	LastProbeAt   *time.Time
+	LastSuccessAt *time.Time
+}
+
+// GetProbesStats returns summary of last probes of enabled sources URLs.
+func GetProbesStats() *ProbesStats {
+	stats := &ProbesStats{}
+	err := database.DB.QueryRow("SELECT COALESCE(SUM(reachable=1), 0), COALESCE(SUM(reachable=0), 0), COALESCE(SUM(reachable IS NULL), 0), MAX(probed_at), MAX(last_success_at) FROM `packages_urls` WHERE enabled=1").Scan(&stats.Reachable, &stats.Failing, &stats.NotProbed, &stats.LastProbeAt, &stats.LastSuccessAt)
+	if err != nil {
+		log.Error().Msgf("Failed to get sources URLs probes stats: %s", err.Error())
+	}
+
+	return stats
+}
+
+// GetFailingURLs returns enabled sources URLs which were unreachable on
+// last probe, longest failing first.
+func GetFailingURLs(limit int) []*URL {
+	urls := []*URL{}
+	err := database.DB.Select(&urls, database.DB.Rebind("SELECT * FROM `packages_urls` WHERE enabled=1 AND reachable=0 ORDER BY last_success_at IS NOT NULL, last_success_at, id LIMIT ?"), limit)
+	if err != nil {
+		log.Error().Msgf("Failed to get failing sources URLs: %s", err.Error())
+		return nil
+	}
+
+	return urls
+}
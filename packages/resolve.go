@@ -0,0 +1,108 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"html"
+	"net"
+	"net/http"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Resolution describes what "go get" receives for import path.
+type Resolution struct {
+	// Package which serves import path, nil if there is no such package.
+	Package *Package
+	// True if package exists, but isn't visible to requesting user.
+	Hidden bool
+	// Routing result, nil if routing wasn't done.
+	Route *RoutingResult
+	// HTTP status of response.
+	Status int
+	// Value of Retry-After header, zero if header isn't sent.
+	RetryAfter int
+	// Response body. Empty for 404, which is answered with common
+	// "not found" page.
+	Body string
+}
+
+// Resolve decides what "go get" made by user with passed ID (zero for
+// anonymous) from passed IP to passed host receives for import path.
+func Resolve(ec echo.Context, importPath string, ip net.IP, host string, uid int) *Resolution {
+	res := &Resolution{Status: http.StatusNotFound}
+
+	pkg := FindPackageForImportPath(importPath)
+	if pkg == nil {
+		return res
+	}
+
+	res.Package = pkg
+	if !pkg.IsVisibleTo(uid) {
+		res.Hidden = true
+		return res
+	}
+
+	if pkg.State == StateMaintenance {
+		message := "Package " + pkg.OriginalPackageURL + " is under maintenance, please try again later."
+		if pkg.StateReason != "" {
+			message += "\nReason: " + pkg.StateReason
+		}
+
+		res.Status = http.StatusServiceUnavailable
+		res.RetryAfter = MaintenanceRetryAfter()
+		res.Body = message + "\n"
+		return res
+	}
+
+	res.Route = pkg.Route(ip, host)
+	url := res.Route.URL
+	if url == nil {
+		return res
+	}
+
+	res.Status = http.StatusOK
+	res.Body = templater.GetRawTemplate(ec, "packages/go_get.html", map[string]string{
+		"package.import_path": html.EscapeString(pkg.OriginalPackageURL),
+		"package.vcs":         html.EscapeString(url.VCS),
+		"package.url":         html.EscapeString(url.URL),
+	})
+
+	return res
+}
+
+// MaintenanceRetryAfter returns how many seconds clients should wait
+// before retrying to get package which is under maintenance.
+func MaintenanceRetryAfter() int {
+	retryAfter := settings.Int("packages.maintenance_retry_after")
+	if retryAfter <= 0 {
+		return defaultMaintenanceRetryAfter
+	}
+
+	return retryAfter
+}
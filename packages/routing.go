@@ -0,0 +1,246 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	h "github.com/welltrainedfolks/magister/internal/http"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Routing policy match types.
+const (
+	// Policy matches if client's IP belongs to one of networks.
+	MatchCIDR = "cidr"
+	// Policy matches if request was made to one of hosts.
+	MatchHost = "host"
+)
+
+// RoutingPolicy describes which mirror should be given to clients
+// matching it. Global policies have zero package ID and are checked
+// after package's own policies.
+type RoutingPolicy struct {
+	ID         int       `db:"id"`
+	PackageID  int       `db:"package_id"`
+	Priority   int       `db:"priority"`
+	MatchType  string    `db:"match_type"`
+	MatchValue string    `db:"match_value"`
+	Mirror     string    `db:"mirror"`
+	Enabled    bool      `db:"enabled"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// RoutingResult describes how package was routed.
+type RoutingResult struct {
+	// Selected sources URL, nil if package has no enabled URLs.
+	URL *URL
+	// Policy which selected URL, nil if default URL was selected.
+	Policy *RoutingPolicy
+	// Policies which matched, but wasn't used because package has no
+	// enabled URL for their mirror.
+	Skipped []*RoutingPolicy
+}
+
+// GetRoutingPolicies returns policies for package with passed ID (zero
+// means global policies) in order they should be checked.
+func GetRoutingPolicies(packageID int) []*RoutingPolicy {
+	policies := []*RoutingPolicy{}
+	err := database.DB.Select(&policies, database.DB.Rebind("SELECT * FROM `routing_policies` WHERE package_id=? ORDER BY priority, id"), packageID)
+	if err != nil {
+		log.Error().Msgf("Failed to get routing policies for package #%d: %s", packageID, err.Error())
+		return nil
+	}
+
+	return policies
+}
+
+// GetAllRoutingPolicies returns all routing policies, global first.
+func GetAllRoutingPolicies() []*RoutingPolicy {
+	policies := []*RoutingPolicy{}
+	err := database.DB.Select(&policies, "SELECT * FROM `routing_policies` ORDER BY package_id, priority, id")
+	if err != nil {
+		log.Error().Msgf("Failed to get routing policies: %s", err.Error())
+		return nil
+	}
+
+	return policies
+}
+
+// GetRoutingPolicyByID returns routing policy by ID.
+func GetRoutingPolicyByID(id int) *RoutingPolicy {
+	policy := &RoutingPolicy{}
+	err := database.DB.Get(policy, database.DB.Rebind("SELECT * FROM `routing_policies` WHERE id=?"), id)
+	if err != nil {
+		log.Error().Msgf("Failed to get routing policy with id '%d': %s", id, err.Error())
+		return nil
+	}
+
+	return policy
+}
+
+// Validate checks policy's data.
+func (rp *RoutingPolicy) Validate() error {
+	if rp.Mirror == "" {
+		return errors.New("mirror name should not be empty")
+	}
+
+	values := rp.values()
+	if len(values) == 0 {
+		return errors.New("policy should match at least one CIDR or host")
+	}
+
+	switch rp.MatchType {
+	case MatchCIDR:
+		for _, value := range values {
+			if _, err := h.ParseCIDR(value); err != nil {
+				return errors.New("invalid CIDR '" + value + "'")
+			}
+		}
+	case MatchHost:
+	default:
+		return errors.New("unknown match type '" + rp.MatchType + "'")
+	}
+
+	return nil
+}
+
+// Create inserts policy into database.
+func (rp *RoutingPolicy) Create() error {
+	if err := rp.Validate(); err != nil {
+		return err
+	}
+
+	if rp.CreatedAt.IsZero() {
+		rp.CreatedAt = time.Now().UTC()
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `routing_policies` (package_id, priority, match_type, match_value, mirror, enabled, created_at) VALUES (:package_id, :priority, :match_type, :match_value, :mirror, :enabled, :created_at)", rp)
+	if err != nil {
+		return err
+	}
+
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+
+	rp.ID = int(lastInsertedID)
+	return nil
+}
+
+// Delete deletes policy from database.
+func (rp *RoutingPolicy) Delete() error {
+	_, err := database.DB.NamedExec("DELETE FROM `routing_policies` WHERE id=:id", rp)
+	return err
+}
+
+// DeleteRoutingPolicies deletes all package's own routing policies.
+func (p *Package) DeleteRoutingPolicies() error {
+	_, err := database.DB.NamedExec("DELETE FROM `routing_policies` WHERE package_id=:id", p)
+	return err
+}
+
+// Matches returns true if request from passed IP to passed host matches
+// policy.
+func (rp *RoutingPolicy) Matches(ip net.IP, host string) bool {
+	if !rp.Enabled {
+		return false
+	}
+
+	for _, value := range rp.values() {
+		switch rp.MatchType {
+		case MatchCIDR:
+			network, err := h.ParseCIDR(value)
+			if err == nil && ip != nil && network.Contains(ip) {
+				return true
+			}
+		case MatchHost:
+			if strings.EqualFold(value, host) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Returns list of CIDRs or hosts policy matches.
+func (rp *RoutingPolicy) values() []string {
+	var values []string
+	for _, value := range strings.Split(rp.MatchValue, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// Route selects sources URL for client with passed IP which requested
+// package on passed host. Package's own policies are checked first,
+// then global ones. First matching policy for which package has enabled
+// URL with policy's mirror name wins. If nothing matched - first enabled
+// URL is selected.
+func (p *Package) Route(ip net.IP, host string) *RoutingResult {
+	result := &RoutingResult{}
+
+	var urls []*URL
+	for _, url := range p.GetURLs() {
+		if url.Enabled {
+			urls = append(urls, url)
+		}
+	}
+
+	if len(urls) == 0 {
+		return result
+	}
+
+	policies := append(GetRoutingPolicies(p.ID), GetRoutingPolicies(0)...)
+	for _, policy := range policies {
+		if !policy.Matches(ip, host) {
+			continue
+		}
+
+		for _, url := range urls {
+			if url.Mirror == policy.Mirror {
+				result.URL = url
+				result.Policy = policy
+				return result
+			}
+		}
+
+		result.Skipped = append(result.Skipped, policy)
+	}
+
+	result.URL = urls[0]
+	return result
+}
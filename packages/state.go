@@ -0,0 +1,182 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"errors"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/users"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Package lifecycle states.
+const (
+	// Package is being prepared and visible only to it's owner and to
+	// users who manage packages.
+	StateDraft = "draft"
+	// Package is served and listed in catalog.
+	StatePublished = "published"
+	// Package is temporarily unavailable, "go get" will receive 503.
+	StateMaintenance = "maintenance"
+	// Package is still served, but hidden from catalog.
+	StateArchived = "archived"
+)
+
+// States lists all package states in order they should be shown.
+var States = []string{StateDraft, StatePublished, StateMaintenance, StateArchived}
+
+// Allowed state transitions.
+var stateTransitions = map[string][]string{
+	StateDraft:       {StatePublished, StateArchived},
+	StatePublished:   {StateMaintenance, StateArchived},
+	StateMaintenance: {StatePublished, StateArchived},
+	StateArchived:    {StatePublished},
+}
+
+// StateChange represents single package's state transition.
+type StateChange struct {
+	ID        int       `db:"id"`
+	PackageID int       `db:"package_id"`
+	FromState string    `db:"from_state"`
+	ToState   string    `db:"to_state"`
+	ChangedBy int       `db:"changed_by"`
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// IsValidState returns true if passed string is a known package state.
+func IsValidState(state string) bool {
+	_, found := stateTransitions[state]
+	return found
+}
+
+// CanTransitTo returns true if package can be moved to passed state.
+func (p *Package) CanTransitTo(state string) bool {
+	for _, s := range stateTransitions[p.State] {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetStateChanges returns package's state transitions history, newest
+// first.
+func (p *Package) GetStateChanges() []*StateChange {
+	changes := []*StateChange{}
+	err := database.DB.Select(&changes, database.DB.Rebind("SELECT * FROM `packages_states_changes` WHERE package_id=? ORDER BY id DESC"), p.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to get state changes for package #%d: %s", p.ID, err.Error())
+		return nil
+	}
+
+	return changes
+}
+
+// IsVisibleTo returns true if package can be seen by user with passed
+// ID. Zero ID means anonymous user. Drafts are visible to their owners
+// and to users who manage packages, so drafts without owner (e.g.
+// created by magisterctl) aren't lost.
+func (p *Package) IsVisibleTo(uid int) bool {
+	if p.State != StateDraft {
+		return true
+	}
+
+	if uid == 0 {
+		return false
+	}
+
+	if p.OwnerID == uid {
+		return true
+	}
+
+	u := users.GetUserByID(uid)
+	return u != nil && u.Can(users.PermPackagesManage)
+}
+
+// SetState moves package to passed state and records transition. UID
+// is an ID of user who made the change, zero means magisterctl.
+func (p *Package) SetState(state string, uid int, reason string) error {
+	if !IsValidState(state) {
+		return errors.New("unknown package state '" + state + "'")
+	}
+
+	if !p.CanTransitTo(state) {
+		return errors.New("package cannot be moved from '" + p.State + "' to '" + state + "'")
+	}
+
+	return p.forceState(state, uid, reason)
+}
+
+// Moves package to passed state without checking whether transition is
+// allowed and records transition.
+func (p *Package) forceState(state string, uid int, reason string) error {
+	if !IsValidState(state) {
+		return errors.New("unknown package state '" + state + "'")
+	}
+
+	sc := &StateChange{
+		PackageID: p.ID,
+		FromState: p.State,
+		ToState:   state,
+		ChangedBy: uid,
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	tx, err := database.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	if _, err1 := tx.Exec("UPDATE `packages` SET state=?, state_reason=?, updated_at=? WHERE id=?", state, reason, sc.CreatedAt, p.ID); err1 != nil {
+		tx.Rollback()
+		return err1
+	}
+
+	if _, err2 := tx.NamedExec("INSERT INTO `packages_states_changes` (package_id, from_state, to_state, changed_by, reason, created_at) VALUES (:package_id, :from_state, :to_state, :changed_by, :reason, :created_at)", sc); err2 != nil {
+		tx.Rollback()
+		return err2
+	}
+
+	if err3 := tx.Commit(); err3 != nil {
+		return err3
+	}
+
+	p.State = state
+	p.StateReason = reason
+	p.UpdatedAt = sc.CreatedAt
+
+	data := p.eventData()
+	data.PreviousState = sc.FromState
+	webhooks.Emit(webhooks.EventPackageStateChanged, data)
+
+	return nil
+}
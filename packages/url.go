@@ -0,0 +1,276 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"errors"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// URL represents single sources URL for package.
+type URL struct {
+	ID        int    `db:"id"`
+	PackageID int    `db:"package_id"`
+	URL       string `db:"url"`
+	VCS       string `db:"vcs"`
+	Mirror    string `db:"mirror"`
+	Enabled   bool   `db:"enabled"`
+	Position  int    `db:"position"`
+	// Result of last scheduled probe, nil if URL wasn't probed yet.
+	Reachable     *bool      `db:"reachable"`
+	ProbedAt      *time.Time `db:"probed_at"`
+	LastSuccessAt *time.Time `db:"last_success_at"`
+	LastError     string     `db:"last_error"`
+}
+
+// VCSes which are known to "go get".
+var KnownVCSes = []string{"bzr", "fossil", "git", "hg", "svn"}
+
+// IsKnownVCS returns true if passed VCS name is known to "go get".
+func IsKnownVCS(vcs string) bool {
+	for _, v := range KnownVCSes {
+		if v == vcs {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateURL checks sources URL and it's VCS.
+func ValidateURL(url string, vcs string) error {
+	if strings.TrimSpace(url) == "" || strings.ContainsAny(url, " \t\"'<>") {
+		return errors.New("sources URL should not be empty or contain spaces or quotes")
+	}
+
+	if !IsKnownVCS(vcs) {
+		return errors.New("unknown VCS '" + vcs + "'")
+	}
+
+	return nil
+}
+
+// AddURL adds sources URL to package. Empty VCS means "git". Mirror is
+// a name which routing policies use to select this URL, it might be
+// empty.
+func (p *Package) AddURL(url string, vcs string, mirror string, enabled bool) error {
+	if vcs == "" {
+		vcs = "git"
+	}
+
+	u := &URL{
+		PackageID: p.ID,
+		URL:       url,
+		VCS:       vcs,
+		Mirror:    mirror,
+		Enabled:   enabled,
+	}
+
+	// New URL goes to the end of list.
+	var position int
+	err := database.DB.Get(&position, database.DB.Rebind("SELECT COALESCE(MAX(position), 0) FROM `packages_urls` WHERE package_id=?"), p.ID)
+	if err != nil {
+		return err
+	}
+	u.Position = position + 1
+
+	_, err1 := database.DB.NamedExec("INSERT INTO `packages_urls` (package_id, url, vcs, mirror, enabled, position) VALUES (:package_id, :url, :vcs, :mirror, :enabled, :position)", u)
+	return err1
+}
+
+// CountURLs returns count of enabled and disabled sources URLs.
+func CountURLs() (enabled int, disabled int) {
+	err := database.DB.QueryRow("SELECT COALESCE(SUM(enabled), 0), COALESCE(SUM(NOT enabled), 0) FROM `packages_urls`").Scan(&enabled, &disabled)
+	if err != nil {
+		log.Error().Msgf("Failed to count sources URLs: %s", err.Error())
+	}
+
+	return enabled, disabled
+}
+
+// GetURLByID returns sources URL by it's ID.
+func GetURLByID(id int) *URL {
+	url := &URL{}
+	err := database.DB.Get(url, database.DB.Rebind("SELECT * FROM `packages_urls` WHERE id=?"), id)
+	if err != nil {
+		log.Error().Msgf("Failed to get package URL with id '%d': %s", id, err.Error())
+		return nil
+	}
+
+	return url
+}
+
+// Save updates sources URL in database.
+func (u *URL) Save() error {
+	_, err := database.DB.NamedExec("UPDATE `packages_urls` SET url=:url, vcs=:vcs, mirror=:mirror, enabled=:enabled, position=:position WHERE id=:id", u)
+	return err
+}
+
+// Delete deletes sources URL from database.
+func (u *URL) Delete() error {
+	_, err := database.DB.NamedExec("DELETE FROM `packages_urls` WHERE id=:id", u)
+	return err
+}
+
+// MoveURL moves sources URL up (negative offset) or down (positive
+// offset) in package's URLs list by swapping it with neighbour.
+func (p *Package) MoveURL(u *URL, offset int) error {
+	urls := p.GetURLs()
+	if urls == nil {
+		return errors.New("failed to get package's URLs")
+	}
+
+	idx := -1
+	for i, url := range urls {
+		if url.ID == u.ID {
+			idx = i
+		}
+	}
+
+	if idx == -1 {
+		return errors.New("URL doesn't belong to package")
+	}
+
+	target := idx + offset
+	if target < 0 || target >= len(urls) {
+		return nil
+	}
+
+	// Positions might be equal (e.g. never reordered), so list order is
+	// renumbered while swapping.
+	urls[idx], urls[target] = urls[target], urls[idx]
+
+	tx, err := database.DB.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for i, url := range urls {
+		if _, err1 := tx.Exec(tx.Rebind("UPDATE `packages_urls` SET position=? WHERE id=?"), i+1, url.ID); err1 != nil {
+			tx.Rollback()
+			return err1
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteURLs deletes all sources URLs for package.
+func (p *Package) DeleteURLs() error {
+	_, err := database.DB.NamedExec("DELETE FROM `packages_urls` WHERE package_id=:id", p)
+	return err
+}
+
+// GetURLs returns all sources URLs for package in their order.
+func (p *Package) GetURLs() []*URL {
+	urls := []*URL{}
+	err := database.DB.Select(&urls, database.DB.Rebind("SELECT * FROM `packages_urls` WHERE package_id=? ORDER BY position, id"), p.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to get URLs for package #%d: %s", p.ID, err.Error())
+		return nil
+	}
+
+	return urls
+}
+
+// NormalizeRepositoryURL returns repository URL in form which allows
+// comparing URLs of same repository regardless of scheme, credentials,
+// port or ".git" suffix. E.g. both "https://github.com/user/repo.git"
+// and "git@github.com:user/repo" becomes "github.com/user/repo".
+func NormalizeRepositoryURL(url string) string {
+	url = strings.ToLower(strings.TrimSpace(url))
+
+	var hasScheme bool
+	if idx := strings.Index(url, "://"); idx != -1 {
+		url = url[idx+3:]
+		hasScheme = true
+	}
+
+	// Credentials.
+	if at := strings.Index(url, "@"); at != -1 {
+		if slash := strings.Index(url, "/"); slash == -1 || at < slash {
+			url = url[at+1:]
+		}
+	}
+
+	if idx := strings.Index(url, ":"); idx != -1 {
+		slash := strings.Index(url, "/")
+		if slash == -1 || idx < slash {
+			if hasScheme {
+				// Port.
+				if slash == -1 {
+					url = url[:idx]
+				} else {
+					url = url[:idx] + url[slash:]
+				}
+			} else {
+				// scp-like "host:path".
+				url = url[:idx] + "/" + strings.TrimPrefix(url[idx+1:], "/")
+			}
+		}
+	}
+
+	url = strings.TrimSuffix(url, "/")
+	url = strings.TrimSuffix(url, ".git")
+
+	return url
+}
+
+// FindPackagesByRepositoryURL returns packages which have sources URL
+// pointing to passed repository.
+func FindPackagesByRepositoryURL(repositoryURL string) []*Package {
+	normalized := NormalizeRepositoryURL(repositoryURL)
+	if normalized == "" {
+		return nil
+	}
+
+	urls := []*URL{}
+	err := database.DB.Select(&urls, "SELECT * FROM `packages_urls` ORDER BY id")
+	if err != nil {
+		log.Error().Msgf("Failed to get packages URLs: %s", err.Error())
+		return nil
+	}
+
+	var pkgs []*Package
+	seen := make(map[int]bool)
+	for _, url := range urls {
+		if seen[url.PackageID] || NormalizeRepositoryURL(url.URL) != normalized {
+			continue
+		}
+
+		pkg := GetPackageByID(url.PackageID)
+		if pkg != nil {
+			seen[url.PackageID] = true
+			pkgs = append(pkgs, pkg)
+		}
+	}
+
+	return pkgs
+}
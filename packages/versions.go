@@ -0,0 +1,69 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package packages
+
+import (
+	// stdlib
+	"sort"
+)
+
+// ModuleVersions is a list of module's versions which were served.
+type ModuleVersions struct {
+	Module   string
+	Versions []string
+}
+
+// VersionsLister returns served versions of package's modules. Versions
+// are known only to module proxy, which imports this package, so it's
+// set from there.
+type VersionsLister func(pkg *Package) []*ModuleVersions
+
+var versionsLister VersionsLister
+
+// SetVersionsLister sets function which lists served versions of
+// package's modules.
+func SetVersionsLister(f VersionsLister) {
+	versionsLister = f
+}
+
+// ServedVersions returns served versions of package's modules, sorted
+// by module path. Module with package's import path is always present,
+// with nil versions if they aren't known.
+func (p *Package) ServedVersions() []*ModuleVersions {
+	var modules []*ModuleVersions
+	if versionsLister != nil {
+		modules = versionsLister(p)
+	}
+
+	for _, m := range modules {
+		if m.Module == p.OriginalPackageURL {
+			return modules
+		}
+	}
+
+	modules = append(modules, &ModuleVersions{Module: p.OriginalPackageURL})
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Module < modules[j].Module
+	})
+
+	return modules
+}
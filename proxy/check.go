@@ -0,0 +1,191 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"sync"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// How many modules might wait for comparison with upstream.
+const checkQueueSize = 256
+
+// Modules queued for comparison with upstream. Single worker compares
+// them one by one, so burst of requests doesn't run go tool for every
+// one at once, and module which is already waiting isn't queued again.
+var (
+	checkQueue       = make(chan string, checkQueueSize)
+	checkQueued      = make(map[string]bool)
+	checkQueuedMutex sync.Mutex
+)
+
+// QueueCheck queues module for comparison with upstream. Returns false
+// if module is already waiting or queue is full.
+func QueueCheck(module string) bool {
+	checkQueuedMutex.Lock()
+	defer checkQueuedMutex.Unlock()
+
+	if checkQueued[module] {
+		return false
+	}
+
+	select {
+	case checkQueue <- module:
+		checkQueued[module] = true
+		return true
+	default:
+		log.Warn().Msgf("Queue of modules to compare with upstream is full, '%s' isn't queued", module)
+		return false
+	}
+}
+
+// Compares queued modules with upstream. Module is removed from queued
+// ones before it's compared, so change made during comparison queues it
+// again.
+func checkWorker() {
+	for module := range checkQueue {
+		checkQueuedMutex.Lock()
+		delete(checkQueued, module)
+		checkQueuedMutex.Unlock()
+
+		if err := CheckModule(module); err != nil {
+			log.Error().Msgf("Failed to compare snapshots of '%s' with upstream: %s", module, err.Error())
+		}
+	}
+}
+
+// CheckModule compares module's snapshots with upstream. Tagged
+// versions which vanished upstream are flagged (and pinned, so they're
+// kept), versions which are newer than every snapshotted one are
+// snapshotted right away.
+func CheckModule(module string) error {
+	pkg := packages.FindPackageForImportPath(module)
+	if pkg == nil {
+		return nil
+	}
+
+	// Failed check isn't retried until next interval too.
+	if err := setModuleChecked(module); err != nil {
+		return err
+	}
+
+	l, err1 := listUpstream(module)
+	if err1 != nil {
+		return err1
+	}
+
+	upstream := make(map[string]bool)
+	for _, version := range l.Versions {
+		upstream[version] = true
+	}
+
+	var newest string
+	for _, s := range GetModuleSnapshots(module) {
+		// Pseudo-versions are commits, which aren't listed.
+		if isPseudoVersion(s.Version) {
+			continue
+		}
+
+		if newest == "" || helpers.CompareVersions(s.Version, newest) > 0 {
+			newest = s.Version
+		}
+
+		switch {
+		case !upstream[s.Version] && s.Discrepancy == "":
+			log.Warn().Msgf("Snapshotted version '%s@%s' isn't available upstream anymore", module, s.Version)
+			flagDiscrepancy(pkg, s, DiscrepancyMissing)
+		case upstream[s.Version] && s.Discrepancy == DiscrepancyMissing:
+			// Tag is back. Snapshot stays pinned, administrator
+			// decides whether it should.
+			log.Info().Msgf("Snapshotted version '%s@%s' is available upstream again", module, s.Version)
+			if err2 := s.setDiscrepancy(""); err2 != nil {
+				log.Error().Msgf("Failed to clear discrepancy of '%s@%s' snapshot: %s", module, s.Version, err2.Error())
+			}
+		}
+	}
+
+	for _, version := range l.Versions {
+		if newest == "" || helpers.CompareVersions(version, newest) <= 0 {
+			continue
+		}
+
+		if _, err3 := snapshotVersion(pkg, module, version); err3 != nil {
+			log.Error().Msgf("Failed to snapshot new version '%s@%s': %s", module, version, err3.Error())
+		}
+	}
+
+	return nil
+}
+
+// Compares snapshotted modules with upstream when it's time to,
+// removes files of snapshots which weren't downloaded for retention
+// period and reads requirements of snapshots which were made before
+// they were tracked.
+func maintain() {
+	if !Enabled() {
+		return
+	}
+
+	indexSnapshots()
+
+	if days := settings.Int("proxy.retention_days"); days > 0 {
+		pruneSnapshots(time.Now().UTC().AddDate(0, 0, -days))
+	}
+
+	hours := settings.Int("proxy.check_hours")
+	if hours <= 0 {
+		return
+	}
+
+	for _, module := range getModulesCheckedBefore(time.Now().UTC().Add(-time.Duration(hours) * time.Hour)) {
+		if err := CheckModule(module); err != nil {
+			log.Error().Msgf("Failed to compare snapshots of '%s' with upstream: %s", module, err.Error())
+		}
+	}
+}
+
+// Removes files of unpinned snapshots which weren't downloaded since
+// passed time.
+func pruneSnapshots(before time.Time) {
+	var pruned int
+	for _, s := range getSnapshotsServedBefore(before) {
+		if err := s.prune(); err != nil {
+			log.Error().Msgf("Failed to remove files of '%s@%s' snapshot: %s", s.Module, s.Version, err.Error())
+			continue
+		}
+
+		pruned++
+	}
+
+	if pruned != 0 {
+		log.Info().Msgf("Removed files of %d snapshots which weren't downloaded since %s", pruned, before.Format("2006-01-02"))
+	}
+}
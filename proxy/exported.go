@@ -0,0 +1,72 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Prefix for module proxy endpoints.
+// "GOPROXY=https://go.example.com/proxy/" uses it.
+const proxyPrefix = "/proxy/"
+
+// How often it's checked whether snapshots should be compared with
+// upstream or removed by retention.
+const maintenanceInterval = 10 * time.Minute
+
+func Initialize() {
+	log.Info().Msg("Initializing 'proxy' module...")
+
+	if settings.Bool("features.proxy") && config.Config.Proxy.StoragePath == "" {
+		log.Warn().Msg("Module proxy is enabled, but proxy.storage_path isn't set in configuration file, so it's disabled")
+	}
+
+	http.E.GET(proxyPrefix+"*", proxyGET)
+
+	packages.SetDependenciesRenderer(packageDependencies)
+	packages.SetVersionsLister(packageVersions)
+
+	go checkWorker()
+
+	go func() {
+		for {
+			maintain()
+			time.Sleep(maintenanceInterval)
+		}
+	}()
+}
+
+// Enabled returns true if module proxy is enabled and has storage for
+// snapshots.
+func Enabled() bool {
+	return settings.Bool("features.proxy") && config.Config.Proxy.StoragePath != ""
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"sort"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Dependency is an edge of dependency graph between served modules:
+// module's version requires other module's version.
+type Dependency struct {
+	Module          string
+	Version         string
+	Requires        string
+	RequiresVersion string
+	Indirect        bool
+	// True if Version is module's latest snapshotted version.
+	Latest bool
+}
+
+// Graph lookups. Graph is built from snapshots, so it's as fresh as
+// latest snapshot.
+type graphLookup struct {
+	served map[string]bool
+	latest map[string]string
+}
+
+func newGraphLookup() *graphLookup {
+	return &graphLookup{served: make(map[string]bool), latest: make(map[string]string)}
+}
+
+// Returns true if module belongs to served package.
+func (g *graphLookup) isServed(module string) bool {
+	served, known := g.served[module]
+	if !known {
+		served = packages.FindPackageForImportPath(module) != nil
+		g.served[module] = served
+	}
+
+	return served
+}
+
+// Returns module's latest snapshotted version.
+func (g *graphLookup) latestVersion(module string) string {
+	version, known := g.latest[module]
+	if !known {
+		if s := LatestSnapshot(module); s != nil {
+			version = s.Version
+		}
+		g.latest[module] = version
+	}
+
+	return version
+}
+
+// LatestSnapshot returns snapshot of module's latest version: latest
+// tagged one, or latest commit if module has no tags, like go tool
+// does. Returns nil if module wasn't snapshotted.
+func LatestSnapshot(module string) *Snapshot {
+	var latest *Snapshot
+	for _, s := range GetModuleSnapshots(module) {
+		if latest == nil || isNewer(s, latest) {
+			latest = s
+		}
+	}
+
+	return latest
+}
+
+// ServedModules returns snapshotted modules of served packages.
+func ServedModules() []string {
+	all := []string{}
+	err := database.DB.Select(&all, "SELECT DISTINCT module FROM `packages_versions` ORDER BY module")
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshotted modules: %s", err.Error())
+		return nil
+	}
+
+	g := newGraphLookup()
+	modules := []string{}
+	for _, module := range all {
+		if g.isServed(module) {
+			modules = append(modules, module)
+		}
+	}
+
+	return modules
+}
+
+// PackageModules returns snapshotted modules which belong to package.
+// Modules under package's import path might belong to other package
+// with longer import path.
+func PackageModules(pkg *packages.Package) []string {
+	var modules []string
+	for _, module := range GetModules(pkg.OriginalPackageURL) {
+		if p := packages.FindPackageForImportPath(module); p != nil && p.ID == pkg.ID {
+			modules = append(modules, module)
+		}
+	}
+
+	return modules
+}
+
+// DependsOn returns served modules which module's version requires.
+// Latest version is used if version is empty.
+func DependsOn(module string, version string) []*Dependency {
+	g := newGraphLookup()
+	return g.dependsOn(module, version)
+}
+
+func (g *graphLookup) dependsOn(module string, version string) []*Dependency {
+	if version == "" {
+		version = g.latestVersion(module)
+	}
+
+	s := GetSnapshot(module, version)
+	if s == nil {
+		return nil
+	}
+
+	var deps []*Dependency
+	for _, r := range GetRequirements(s.ID) {
+		if !g.isServed(r.Module) {
+			continue
+		}
+
+		deps = append(deps, &Dependency{
+			Module:          module,
+			Version:         version,
+			Requires:        r.Module,
+			RequiresVersion: r.Version,
+			Indirect:        r.Indirect,
+			Latest:          version == g.latestVersion(module),
+		})
+	}
+
+	return deps
+}
+
+// UsedBy returns served modules which latest versions require module.
+func UsedBy(module string) []*Dependency {
+	g := newGraphLookup()
+	return g.usedBy(module)
+}
+
+func (g *graphLookup) usedBy(module string) []*Dependency {
+	var deps []*Dependency
+	for _, r := range getRequiring(module) {
+		if !g.isServed(r.Module) || r.ModuleVersion != g.latestVersion(r.Module) {
+			continue
+		}
+
+		deps = append(deps, &Dependency{
+			Module:          r.Module,
+			Version:         r.ModuleVersion,
+			Requires:        module,
+			RequiresVersion: r.Version,
+			Indirect:        r.Indirect,
+			Latest:          true,
+		})
+	}
+
+	return deps
+}
+
+// Impact returns every snapshotted version of served modules which
+// requires module at passed version: "v1.4" matches v1.4 and every
+// v1.4.x, empty version matches everything. Answers "if I break this
+// version, who is affected".
+func Impact(module string, version string) []*Dependency {
+	g := newGraphLookup()
+
+	var deps []*Dependency
+	for _, r := range getRequiring(module) {
+		if !g.isServed(r.Module) || !matchesVersion(r.Version, version) {
+			continue
+		}
+
+		deps = append(deps, &Dependency{
+			Module:          r.Module,
+			Version:         r.ModuleVersion,
+			Requires:        module,
+			RequiresVersion: r.Version,
+			Indirect:        r.Indirect,
+			Latest:          r.ModuleVersion == g.latestVersion(r.Module),
+		})
+	}
+
+	sort.SliceStable(deps, func(i, j int) bool {
+		if deps[i].Module != deps[j].Module {
+			return deps[i].Module < deps[j].Module
+		}
+
+		return helpers.CompareVersions(deps[i].Version, deps[j].Version) > 0
+	})
+
+	return deps
+}
+
+// Graph returns dependencies between latest versions of every served
+// module.
+func Graph() []*Dependency {
+	g := newGraphLookup()
+
+	var deps []*Dependency
+	for _, module := range ServedModules() {
+		deps = append(deps, g.dependsOn(module, "")...)
+	}
+
+	return deps
+}
+
+// Returns true if version matches query: query itself, or query's
+// patch versions and pre-releases if query is a prefix like "v1.4".
+func matchesVersion(version string, query string) bool {
+	if query == "" || version == query {
+		return true
+	}
+
+	return strings.HasPrefix(version, query+".") || strings.HasPrefix(version, query+"-")
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Content types of proxy protocol's files.
+var contentTypes = map[string]string{
+	".info": echo.MIMEApplicationJSON,
+	".mod":  echo.MIMETextPlainCharsetUTF8,
+	".zip":  "application/zip",
+}
+
+// Version's metadata, the .info file.
+type versionInfo struct {
+	Version string
+	Time    time.Time
+}
+
+// Answers go tool's requests, see "go help goproxy": list of versions,
+// latest version and version's .info, .mod and .zip. Versions are
+// served from snapshots, which are made on first request.
+func proxyGET(ec echo.Context) error {
+	if !Enabled() {
+		return notFound(ec, "module proxy is disabled")
+	}
+
+	path := strings.TrimPrefix(ec.Request().URL.Path, proxyPrefix)
+
+	var escapedModule, file string
+	if strings.HasSuffix(path, "/@latest") {
+		escapedModule = strings.TrimSuffix(path, "/@latest")
+		file = "@latest"
+	} else if idx := strings.LastIndex(path, "/@v/"); idx != -1 {
+		escapedModule = path[:idx]
+		file = path[idx+len("/@v/"):]
+	} else {
+		return notFound(ec, "unknown proxy request")
+	}
+
+	module, valid := unescapePath(escapedModule)
+	if !valid || !isValidModulePath(module) {
+		return notFound(ec, "invalid module path")
+	}
+
+	pkg := packages.FindPackageForImportPath(module)
+	if pkg == nil || !pkg.IsVisibleTo(currentUID(ec)) {
+		return notFound(ec, "module "+module+" isn't served here")
+	}
+
+	if pkg.State == packages.StateMaintenance {
+		ec.Response().Header().Set("Retry-After", strconv.Itoa(packages.MaintenanceRetryAfter()))
+		return ec.String(http.StatusServiceUnavailable, "package "+pkg.OriginalPackageURL+" is under maintenance, please try again later\n")
+	}
+
+	if file == "@latest" {
+		return latestGET(ec, module)
+	}
+
+	if file == "list" {
+		return listGET(ec, module)
+	}
+
+	ext := filepath.Ext(file)
+	if contentTypes[ext] == "" {
+		return notFound(ec, "unknown proxy request")
+	}
+
+	version, valid := unescapePath(strings.TrimSuffix(file, ext))
+	// Only .info might be requested for query (branch or commit).
+	if !valid || (!isCanonicalVersion(version) && (ext != ".info" || !queryRegexp.MatchString(version))) {
+		return notFound(ec, "invalid version")
+	}
+
+	s, err := snapshotVersion(pkg, module, version)
+	if err != nil {
+		// Upstream's errors might mention sources URLs, which aren't
+		// public.
+		log.Warn().Msgf("Failed to snapshot '%s@%s': %s", module, version, err.Error())
+		if err == errChanged {
+			return ec.String(http.StatusGone, module+"@"+version+": "+err.Error()+"\n")
+		}
+
+		return notFound(ec, module+"@"+version+" isn't available")
+	}
+
+	f, err1 := os.Open(s.path(ext))
+	if err1 != nil {
+		log.Error().Msgf("Failed to open snapshot file: %s", err1.Error())
+		return ec.String(http.StatusInternalServerError, "failed to read snapshot\n")
+	}
+	defer f.Close()
+
+	s.markServed()
+
+	return ec.Stream(http.StatusOK, contentTypes[ext], f)
+}
+
+// Lists tagged versions: both which are available upstream and which
+// were snapshotted, even if they vanished upstream.
+func listGET(ec echo.Context, module string) error {
+	versions := make(map[string]bool)
+
+	l, err := listUpstream(module)
+	if err != nil {
+		log.Warn().Msgf("Failed to list versions of '%s' upstream, only snapshots are listed: %s", module, err.Error())
+	} else {
+		for _, version := range l.Versions {
+			versions[version] = true
+		}
+	}
+
+	for _, s := range GetModuleSnapshots(module) {
+		if !isPseudoVersion(s.Version) {
+			versions[s.Version] = true
+		}
+	}
+
+	list := make([]string, 0, len(versions))
+	for version := range versions {
+		list = append(list, version)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return helpers.CompareVersions(list[i], list[j]) < 0
+	})
+
+	var body string
+	for _, version := range list {
+		body += version + "\n"
+	}
+
+	return ec.String(http.StatusOK, body)
+}
+
+// Returns latest version upstream. If upstream isn't available, latest
+// snapshot is returned.
+func latestGET(ec echo.Context, module string) error {
+	l, err := listUpstream(module)
+	if err == nil {
+		return ec.JSON(http.StatusOK, &versionInfo{Version: l.Version, Time: l.Time})
+	}
+
+	log.Warn().Msgf("Failed to get latest version of '%s' upstream, latest snapshot is used: %s", module, err.Error())
+
+	latest := LatestSnapshot(module)
+	if latest == nil {
+		return notFound(ec, module+" isn't available")
+	}
+
+	return ec.JSON(http.StatusOK, &versionInfo{Version: latest.Version, Time: latest.Time})
+}
+
+// Returns true if snapshot a is of newer version than b.
+func isNewer(a *Snapshot, b *Snapshot) bool {
+	aPseudo := isPseudoVersion(a.Version)
+	bPseudo := isPseudoVersion(b.Version)
+
+	switch {
+	case aPseudo && bPseudo:
+		return a.Time.After(b.Time)
+	case aPseudo != bPseudo:
+		return bPseudo
+	}
+
+	return helpers.CompareVersions(a.Version, b.Version) > 0
+}
+
+// Responds with "not found" in plain text, go tool shows it to user.
+func notFound(ec echo.Context, message string) error {
+	return ec.String(http.StatusNotFound, "not found: "+message+"\n")
+}
+
+// Returns ID of currently logged in user or zero if user isn't logged
+// in.
+func currentUID(ec echo.Context) int {
+	if authorized, _ := ec.Get("AUTHORIZED").(bool); !authorized {
+		return 0
+	}
+
+	return ec.Get("UID").(int)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"html"
+	"sort"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/templater"
+	"github.com/welltrainedfolks/magister/packages"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Renders "depends on" and "used by" of package's modules for package's
+// page. Packages which user can't see aren't mentioned.
+func packageDependencies(ec echo.Context, pkg *packages.Package, uid int) string {
+	if !Enabled() {
+		return ""
+	}
+
+	g := newGraphLookup()
+
+	var modules string
+	for _, module := range PackageModules(pkg) {
+		dependsOn := dependenciesList(ec, g.dependsOn(module, ""), uid, false)
+		usedBy := dependenciesList(ec, g.usedBy(module), uid, true)
+		if dependsOn == "" && usedBy == "" {
+			continue
+		}
+
+		if dependsOn == "" {
+			dependsOn = "<li>None</li>"
+		}
+		if usedBy == "" {
+			usedBy = "<li>None</li>"
+		}
+
+		modules += templater.GetRawTemplate(ec, "packages/dependencies_module.html", map[string]string{
+			"module.path":       html.EscapeString(module),
+			"module.version":    html.EscapeString(g.latestVersion(module)),
+			"module.depends_on": dependsOn,
+			"module.used_by":    usedBy,
+		})
+	}
+
+	if modules == "" {
+		return ""
+	}
+
+	return templater.GetRawTemplate(ec, "packages/dependencies.html", map[string]string{"dependencies.modules": modules})
+}
+
+// Renders list items for dependencies: required modules, or modules
+// which require if usedBy is true.
+func dependenciesList(ec echo.Context, deps []*Dependency, uid int, usedBy bool) string {
+	var items string
+	for _, dep := range deps {
+		module, version := dep.Requires, dep.RequiresVersion
+		if usedBy {
+			module, version = dep.Module, dep.Version
+		}
+
+		pkg := packages.FindPackageForImportPath(module)
+		if pkg == nil || !pkg.IsVisibleTo(uid) {
+			continue
+		}
+
+		var indirect string
+		if dep.Indirect {
+			indirect = " (indirect)"
+		}
+
+		items += templater.GetRawTemplate(ec, "packages/dependency.html", map[string]string{
+			"dependency.module":   html.EscapeString(module),
+			"dependency.version":  html.EscapeString(version),
+			"dependency.indirect": indirect,
+		})
+	}
+
+	return items
+}
+
+// Returns snapshotted versions of package's modules, newest first.
+func packageVersions(pkg *packages.Package) []*packages.ModuleVersions {
+	var modules []*packages.ModuleVersions
+	for _, module := range PackageModules(pkg) {
+		snapshots := GetModuleSnapshots(module)
+		sort.Slice(snapshots, func(i, j int) bool {
+			return isNewer(snapshots[i], snapshots[j])
+		})
+
+		m := &packages.ModuleVersions{Module: module}
+		for _, s := range snapshots {
+			m.Versions = append(m.Versions, s.Version)
+		}
+		modules = append(modules, m)
+	}
+
+	return modules
+}
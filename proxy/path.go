@@ -0,0 +1,108 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// Characters which are allowed in module paths.
+	modulePathRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._~/-]*$`)
+	// Canonical semantic version, pseudo-versions are canonical too.
+	versionRegexp = regexp.MustCompile(`^v[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?(\+incompatible)?$`)
+	// Version query: version, branch or commit.
+	queryRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+-]*$`)
+	// Pseudo-versions end with timestamp and commit hash.
+	pseudoVersionRegexp = regexp.MustCompile(`[.-][0-9]{14}-[0-9a-f]{12}(\+incompatible)?$`)
+)
+
+// Escapes module path or version for proxy protocol and storage:
+// uppercase letters are replaced with "!" followed by lowercase ones.
+func escapePath(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteRune('!')
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// Reverts escapePath. Returns false if escaped string is invalid, e.g.
+// has uppercase letters.
+func unescapePath(escaped string) (string, bool) {
+	var b strings.Builder
+	var bang bool
+	for _, r := range escaped {
+		switch {
+		case bang:
+			if !unicode.IsLower(r) {
+				return "", false
+			}
+			b.WriteRune(unicode.ToUpper(r))
+			bang = false
+		case r == '!':
+			bang = true
+		case unicode.IsUpper(r):
+			return "", false
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String(), !bang
+}
+
+// Returns true if module path is safe to pass to go tool and to use in
+// storage paths.
+func isValidModulePath(module string) bool {
+	if !modulePathRegexp.MatchString(module) {
+		return false
+	}
+
+	for _, element := range strings.Split(module, "/") {
+		if element == "" || element == "." || element == ".." {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true if version is canonical, i.e. might be snapshotted.
+func isCanonicalVersion(version string) bool {
+	return versionRegexp.MatchString(version)
+}
+
+// Returns true if version is a pseudo-version, i.e. commit and not tag.
+func isPseudoVersion(version string) bool {
+	return pseudoVersionRegexp.MatchString(version)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Requirement is a dependency from snapshotted version's go.mod.
+type Requirement struct {
+	VersionID int    `db:"version_id"`
+	Module    string `db:"module"`
+	Version   string `db:"version"`
+	Indirect  bool   `db:"indirect"`
+}
+
+// Requirement of some module's snapshotted version on passed module,
+// see getRequiring.
+type requiring struct {
+	// Module which requires and it's version.
+	Module        string `db:"module"`
+	ModuleVersion string `db:"module_version"`
+	// Required version.
+	Version  string `db:"version"`
+	Indirect bool   `db:"indirect"`
+}
+
+// GetRequirements returns requirements of snapshotted version.
+func GetRequirements(versionID int) []*Requirement {
+	requirements := []*Requirement{}
+	err := database.DB.Select(&requirements, database.DB.Rebind("SELECT * FROM `packages_versions_requirements` WHERE version_id=? ORDER BY module"), versionID)
+	if err != nil {
+		log.Error().Msgf("Failed to get requirements of snapshot #%d: %s", versionID, err.Error())
+		return nil
+	}
+
+	return requirements
+}
+
+// Returns every snapshotted version which requires passed module.
+func getRequiring(module string) []*requiring {
+	rows := []*requiring{}
+	err := database.DB.Select(&rows, database.DB.Rebind("SELECT pv.module AS module, pv.version AS module_version, r.version AS version, r.indirect AS indirect FROM `packages_versions_requirements` r JOIN `packages_versions` pv ON pv.id=r.version_id WHERE r.module=? ORDER BY pv.module, pv.id"), module)
+	if err != nil {
+		log.Error().Msgf("Failed to get versions which require '%s': %s", module, err.Error())
+		return nil
+	}
+
+	return rows
+}
+
+// Returns snapshots which requirements weren't read yet.
+func getUnindexedSnapshots() []*Snapshot {
+	snapshots := []*Snapshot{}
+	err := database.DB.Select(&snapshots, "SELECT * FROM `packages_versions` WHERE requirements_indexed=0 AND stored=1 ORDER BY id")
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshots which requirements weren't read: %s", err.Error())
+		return nil
+	}
+
+	return snapshots
+}
+
+// Reads requirements from snapshot's go.mod and stores them.
+func (s *Snapshot) indexRequirements() error {
+	data, err := ioutil.ReadFile(s.path(".mod"))
+	if err != nil {
+		return err
+	}
+
+	tx, err1 := database.DB.Beginx()
+	if err1 != nil {
+		return err1
+	}
+
+	if _, err2 := tx.Exec(tx.Rebind("DELETE FROM `packages_versions_requirements` WHERE version_id=?"), s.ID); err2 != nil {
+		tx.Rollback()
+		return err2
+	}
+
+	for _, r := range parseRequirements(data) {
+		r.VersionID = s.ID
+		if _, err3 := tx.NamedExec("INSERT INTO `packages_versions_requirements` (version_id, module, version, indirect) VALUES (:version_id, :module, :version, :indirect)", r); err3 != nil {
+			tx.Rollback()
+			return err3
+		}
+	}
+
+	if _, err4 := tx.Exec(tx.Rebind("UPDATE `packages_versions` SET requirements_indexed=1 WHERE id=?"), s.ID); err4 != nil {
+		tx.Rollback()
+		return err4
+	}
+
+	if err5 := tx.Commit(); err5 != nil {
+		return err5
+	}
+
+	s.RequirementsIndexed = true
+
+	return nil
+}
+
+// Reads requirements of snapshots which were made before they were
+// tracked.
+func indexSnapshots() {
+	for _, s := range getUnindexedSnapshots() {
+		if err := s.indexRequirements(); err != nil {
+			log.Error().Msgf("Failed to read requirements of '%s@%s' snapshot: %s", s.Module, s.Version, err.Error())
+		}
+	}
+}
+
+// Parses require directives of go.mod, both single line and blocks.
+// Replacements aren't applied: they work only in main module, not in
+// modules which are required.
+func parseRequirements(data []byte) []*Requirement {
+	var requirements []*Requirement
+	seen := make(map[string]bool)
+
+	var inBlock bool
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		var comment string
+		if idx := strings.Index(line, "//"); idx != -1 {
+			comment = strings.TrimSpace(line[idx+2:])
+			line = strings.TrimSpace(line[:idx])
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if inBlock {
+			if fields[0] == ")" {
+				inBlock = false
+				continue
+			}
+		} else {
+			if fields[0] != "require" {
+				continue
+			}
+
+			if len(fields) == 2 && fields[1] == "(" {
+				inBlock = true
+				continue
+			}
+
+			fields = fields[1:]
+		}
+
+		if len(fields) != 2 {
+			continue
+		}
+
+		module := unquote(fields[0])
+		if seen[module] {
+			continue
+		}
+		seen[module] = true
+
+		requirements = append(requirements, &Requirement{
+			Module:   module,
+			Version:  unquote(fields[1]),
+			Indirect: comment == "indirect" || strings.HasPrefix(comment, "indirect;"),
+		})
+	}
+
+	return requirements
+}
+
+// Unquotes go.mod token if it's quoted.
+func unquote(token string) string {
+	if unquoted, err := strconv.Unquote(token); err == nil {
+		return unquoted
+	}
+
+	return token
+}
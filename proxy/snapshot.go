@@ -0,0 +1,276 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"os"
+	"path/filepath"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Differences between snapshot and upstream.
+const (
+	// Version isn't available upstream anymore.
+	DiscrepancyMissing = "missing"
+	// Version fetched again differs from snapshot.
+	DiscrepancyChanged = "changed"
+)
+
+// Snapshot is an immutable copy of module's version which was served
+// by proxy: module zip, go.mod and .info. Once made, snapshot is never
+// replaced with something else.
+type Snapshot struct {
+	ID      int       `db:"id"`
+	Module  string    `db:"module"`
+	Version string    `db:"version"`
+	Time    time.Time `db:"time"`
+	// Checksums in go.sum format.
+	ZipHash string `db:"zip_hash"`
+	ModHash string `db:"mod_hash"`
+	Size    int64  `db:"size"`
+	// False if files were removed by retention. Checksums are kept, so
+	// version which is fetched again must be identical.
+	Stored bool `db:"stored"`
+	// Pinned snapshots aren't removed by retention.
+	Pinned bool `db:"pinned"`
+	// How upstream differs from snapshot, empty if it doesn't.
+	Discrepancy string `db:"discrepancy"`
+	// True if requirements were read from snapshot's go.mod.
+	RequirementsIndexed bool       `db:"requirements_indexed"`
+	CheckedAt           *time.Time `db:"checked_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+	LastServedAt        time.Time  `db:"last_served_at"`
+}
+
+// SnapshotsFilter describes which snapshots should be returned.
+type SnapshotsFilter struct {
+	// Part of module path.
+	Module string
+	// Return only snapshots which differ from upstream.
+	DiscrepanciesOnly bool
+}
+
+// GetSnapshots returns latest snapshots which match filter.
+func GetSnapshots(f *SnapshotsFilter, limit int) []*Snapshot {
+	query := "SELECT * FROM `packages_versions` WHERE module LIKE ?"
+	if f.DiscrepanciesOnly {
+		query += " AND discrepancy<>''"
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT ?"
+
+	snapshots := []*Snapshot{}
+	err := database.DB.Select(&snapshots, database.DB.Rebind(query), "%"+database.EscapeLike(f.Module)+"%", limit)
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshots: %s", err.Error())
+		return nil
+	}
+
+	return snapshots
+}
+
+// GetSnapshot returns snapshot of module's version or nil if version
+// wasn't snapshotted.
+func GetSnapshot(module string, version string) *Snapshot {
+	s := &Snapshot{}
+	err := database.DB.Get(s, database.DB.Rebind("SELECT * FROM `packages_versions` WHERE module=? AND version=?"), module, version)
+	if err != nil {
+		log.Debug().Msgf("Failed to get snapshot of '%s@%s': %s", module, version, err.Error())
+		return nil
+	}
+
+	return s
+}
+
+// GetSnapshotByID returns snapshot by it's ID.
+func GetSnapshotByID(id int) *Snapshot {
+	s := &Snapshot{}
+	err := database.DB.Get(s, database.DB.Rebind("SELECT * FROM `packages_versions` WHERE id=?"), id)
+	if err != nil {
+		log.Debug().Msgf("Failed to get snapshot #%d: %s", id, err.Error())
+		return nil
+	}
+
+	return s
+}
+
+// GetModuleSnapshots returns all snapshots of module.
+func GetModuleSnapshots(module string) []*Snapshot {
+	snapshots := []*Snapshot{}
+	err := database.DB.Select(&snapshots, database.DB.Rebind("SELECT * FROM `packages_versions` WHERE module=? ORDER BY id"), module)
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshots of '%s': %s", module, err.Error())
+		return nil
+	}
+
+	return snapshots
+}
+
+// GetModules returns paths of snapshotted modules which are served
+// under passed import path, including module with that path itself.
+func GetModules(importPath string) []string {
+	modules := []string{}
+	err := database.DB.Select(&modules, database.DB.Rebind("SELECT DISTINCT module FROM `packages_versions` WHERE module=? OR module LIKE ? ORDER BY module"), importPath, database.EscapeLike(importPath)+"/%")
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshotted modules for '%s': %s", importPath, err.Error())
+		return nil
+	}
+
+	return modules
+}
+
+// Returns modules which weren't compared with upstream since passed
+// time.
+func getModulesCheckedBefore(before time.Time) []string {
+	modules := []string{}
+	err := database.DB.Select(&modules, database.DB.Rebind("SELECT DISTINCT module FROM `packages_versions` WHERE checked_at IS NULL OR checked_at<? ORDER BY module"), before)
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshotted modules to check: %s", err.Error())
+		return nil
+	}
+
+	return modules
+}
+
+// Returns unpinned snapshots with files which weren't served since
+// passed time.
+func getSnapshotsServedBefore(before time.Time) []*Snapshot {
+	snapshots := []*Snapshot{}
+	err := database.DB.Select(&snapshots, database.DB.Rebind("SELECT * FROM `packages_versions` WHERE stored=1 AND pinned=0 AND last_served_at<? ORDER BY id"), before)
+	if err != nil {
+		log.Error().Msgf("Failed to get snapshots for retention: %s", err.Error())
+		return nil
+	}
+
+	return snapshots
+}
+
+// CountDiscrepancies returns how many snapshots differ from upstream.
+func CountDiscrepancies() int {
+	var count int
+	err := database.DB.Get(&count, "SELECT COUNT(*) FROM `packages_versions` WHERE discrepancy<>''")
+	if err != nil {
+		log.Error().Msgf("Failed to count snapshots which differ from upstream: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// AuditData returns snapshot's fields which are written into audit log.
+func (s *Snapshot) AuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"pinned":      s.Pinned,
+		"discrepancy": s.Discrepancy,
+	}
+}
+
+// Creates snapshot in database.
+func (s *Snapshot) create() error {
+	result, err := database.DB.NamedExec("INSERT INTO `packages_versions` (module, version, time, zip_hash, mod_hash, size, stored, pinned, discrepancy, requirements_indexed, checked_at, created_at, last_served_at) VALUES (:module, :version, :time, :zip_hash, :mod_hash, :size, :stored, :pinned, :discrepancy, :requirements_indexed, :checked_at, :created_at, :last_served_at)", s)
+	if err != nil {
+		return err
+	}
+
+	id, err1 := result.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+	s.ID = int(id)
+
+	return nil
+}
+
+// SetPinned pins or unpins snapshot.
+func (s *Snapshot) SetPinned(pinned bool) error {
+	s.Pinned = pinned
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET pinned=? WHERE id=?"), s.Pinned, s.ID)
+	return err
+}
+
+// Marks snapshot as differing from upstream. Such snapshot is pinned,
+// so it isn't removed until administrator unpins it.
+func (s *Snapshot) setDiscrepancy(discrepancy string) error {
+	s.Discrepancy = discrepancy
+	if discrepancy != "" {
+		s.Pinned = true
+	}
+
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET discrepancy=?, pinned=? WHERE id=?"), s.Discrepancy, s.Pinned, s.ID)
+	return err
+}
+
+// Records that snapshot's files were put back into storage.
+func (s *Snapshot) setStored() error {
+	s.Stored = true
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET stored=1 WHERE id=?"), s.ID)
+	return err
+}
+
+// Records that module's versions were compared with upstream.
+func setModuleChecked(module string) error {
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET checked_at=? WHERE module=?"), time.Now().UTC(), module)
+	return err
+}
+
+// Records that snapshot was downloaded. Failure isn't fatal, at worst
+// snapshot is removed by retention earlier.
+func (s *Snapshot) markServed() {
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET last_served_at=? WHERE id=?"), time.Now().UTC(), s.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to update last download time of snapshot '%s@%s': %s", s.Module, s.Version, err.Error())
+	}
+}
+
+// Removes snapshot's files from storage. Snapshot itself with checksums
+// is kept.
+func (s *Snapshot) prune() error {
+	for _, ext := range snapshotFiles {
+		if err := os.Remove(s.path(ext)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	s.Stored = false
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `packages_versions` SET stored=0 WHERE id=?"), s.ID)
+	return err
+}
+
+// Returns path of snapshot's file with passed extension.
+func (s *Snapshot) path(ext string) string {
+	return snapshotPath(s.Module, s.Version, ext)
+}
+
+// Returns path of module version's file with passed extension in
+// storage. Paths are escaped same way as in proxy protocol, so
+// modules which differ only in case don't clash on case-insensitive
+// filesystems.
+func snapshotPath(module string, version string, ext string) string {
+	return filepath.Join(config.Config.Proxy.StoragePath, "snapshots", filepath.FromSlash(escapePath(module)), "@v", escapePath(version)+ext)
+}
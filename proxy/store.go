@@ -0,0 +1,241 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/packages"
+	"github.com/welltrainedfolks/magister/webhooks"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Files every snapshot consists of.
+var snapshotFiles = []string{".info", ".mod", ".zip"}
+
+// errChanged is returned when version fetched again differs from
+// snapshot which files were removed by retention.
+var errChanged = errors.New("upstream version differs from snapshot")
+
+// Snapshotting which is in progress. Concurrent requests for same
+// version wait for first one instead of fetching it again.
+type snapshotCall struct {
+	wg       sync.WaitGroup
+	snapshot *Snapshot
+	err      error
+}
+
+var (
+	snapshotCalls      = make(map[string]*snapshotCall)
+	snapshotCallsMutex sync.Mutex
+)
+
+// Returns stored snapshot of package's module version, snapshotting
+// it if needed. Version might be a query (e.g. branch name), then
+// resolved version is snapshotted.
+func snapshotVersion(pkg *packages.Package, module string, version string) (*Snapshot, error) {
+	if isCanonicalVersion(version) {
+		if s := GetSnapshot(module, version); s != nil && s.Stored {
+			return s, nil
+		}
+	}
+
+	key := module + "@" + version
+
+	snapshotCallsMutex.Lock()
+	if call, inProgress := snapshotCalls[key]; inProgress {
+		snapshotCallsMutex.Unlock()
+		call.wg.Wait()
+		return call.snapshot, call.err
+	}
+
+	call := &snapshotCall{}
+	call.wg.Add(1)
+	snapshotCalls[key] = call
+	snapshotCallsMutex.Unlock()
+
+	call.snapshot, call.err = makeSnapshot(pkg, module, version)
+	call.wg.Done()
+
+	snapshotCallsMutex.Lock()
+	delete(snapshotCalls, key)
+	snapshotCallsMutex.Unlock()
+
+	return call.snapshot, call.err
+}
+
+// Fetches version from upstream and stores it. Version which files
+// were removed by retention is stored again only if it's identical to
+// what was served before.
+func makeSnapshot(pkg *packages.Package, module string, version string) (*Snapshot, error) {
+	cache, err := ioutil.TempDir(storageDir("tmp"), "fetch")
+	if err != nil {
+		return nil, err
+	}
+	defer removeCache(cache)
+
+	d, err1 := fetch(cache, module, version)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	s := GetSnapshot(module, d.Version)
+	if s != nil && s.Stored {
+		return s, nil
+	}
+
+	if s != nil {
+		if d.Sum != s.ZipHash || d.GoModSum != s.ModHash {
+			log.Warn().Msgf("Module '%s@%s' fetched from upstream has checksums %s and %s, but snapshot had %s and %s", module, d.Version, d.Sum, d.GoModSum, s.ZipHash, s.ModHash)
+			flagDiscrepancy(pkg, s, DiscrepancyChanged)
+			return nil, errChanged
+		}
+
+		if err2 := storeFiles(d); err2 != nil {
+			return nil, err2
+		}
+
+		log.Info().Msgf("Files of module '%s@%s' snapshot were fetched again", module, d.Version)
+		return s, s.setStored()
+	}
+
+	info := &struct{ Time time.Time }{}
+	if err3 := readJSON(d.Info, info); err3 != nil {
+		return nil, err3
+	}
+
+	zip, err4 := os.Stat(d.Zip)
+	if err4 != nil {
+		return nil, err4
+	}
+
+	if err5 := storeFiles(d); err5 != nil {
+		return nil, err5
+	}
+
+	now := time.Now().UTC()
+	s = &Snapshot{
+		Module:       module,
+		Version:      d.Version,
+		Time:         info.Time.UTC(),
+		ZipHash:      d.Sum,
+		ModHash:      d.GoModSum,
+		Size:         zip.Size(),
+		Stored:       true,
+		CreatedAt:    now,
+		LastServedAt: now,
+	}
+
+	if err6 := s.create(); err6 != nil {
+		return nil, err6
+	}
+
+	log.Info().Msgf("Module '%s@%s' was snapshotted", module, d.Version)
+
+	if err7 := s.indexRequirements(); err7 != nil {
+		log.Error().Msgf("Failed to read requirements of '%s@%s' snapshot: %s", module, d.Version, err7.Error())
+	}
+
+	pkg.EmitVersion(webhooks.EventPackageVersionAdded, module, d.Version, "")
+
+	return s, nil
+}
+
+// Marks snapshot as differing from upstream and notifies about it.
+func flagDiscrepancy(pkg *packages.Package, s *Snapshot, discrepancy string) {
+	if err := s.setDiscrepancy(discrepancy); err != nil {
+		log.Error().Msgf("Failed to flag snapshot of '%s@%s' as %s upstream: %s", s.Module, s.Version, discrepancy, err.Error())
+		return
+	}
+
+	pkg.EmitVersion(webhooks.EventPackageVersionDiscrepancy, s.Module, s.Version, discrepancy)
+}
+
+// Copies fetched version's files into storage.
+func storeFiles(d *download) error {
+	sources := map[string]string{".info": d.Info, ".mod": d.GoMod, ".zip": d.Zip}
+	for _, ext := range snapshotFiles {
+		if err := copyFile(sources[ext], snapshotPath(d.Path, d.Version, ext)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Copies file. Destination is written under temporary name and renamed,
+// so it's never served half-written.
+func copyFile(source string, destination string) error {
+	if err := os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err1 := ioutil.TempFile(filepath.Dir(destination), ".tmp")
+	if err1 != nil {
+		return err1
+	}
+
+	if _, err2 := io.Copy(out, in); err2 != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return err2
+	}
+
+	if err3 := out.Close(); err3 != nil {
+		os.Remove(out.Name())
+		return err3
+	}
+
+	if err4 := os.Chmod(out.Name(), 0644); err4 != nil {
+		os.Remove(out.Name())
+		return err4
+	}
+
+	return os.Rename(out.Name(), destination)
+}
+
+// Reads JSON file.
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
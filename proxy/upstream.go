@@ -0,0 +1,194 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package proxy
+
+import (
+	// stdlib
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// How long go tool might work on single request to upstream.
+const upstreamTimeout = 10 * time.Minute
+
+// Version fetched by go tool, see "go help mod download".
+type download struct {
+	Path     string
+	Version  string
+	Error    string
+	Info     string
+	GoMod    string
+	Zip      string
+	Sum      string
+	GoModSum string
+}
+
+// Module's versions listed by go tool, see "go help list".
+type listing struct {
+	Path     string
+	Version  string
+	Time     time.Time
+	Versions []string
+}
+
+// Fetches module's version from upstream into passed module cache.
+// Version might be a query (e.g. branch name), fetched version is
+// canonical.
+func fetch(cache string, module string, version string) (*download, error) {
+	out, err := runGo(cache, module, "mod", "download", "-json", module+"@"+version)
+
+	// Failed download is described in JSON too.
+	d := &download{}
+	if len(out) != 0 {
+		if err1 := json.Unmarshal(out, d); err1 != nil {
+			return nil, err1
+		}
+	}
+
+	if d.Error != "" {
+		return nil, errors.New(d.Error)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Path != module || !isCanonicalVersion(d.Version) {
+		return nil, errors.New("go tool fetched '" + d.Path + "@" + d.Version + "' instead of '" + module + "@" + version + "'")
+	}
+
+	return d, nil
+}
+
+// Lists module's tagged versions upstream and resolves latest one.
+func listUpstream(module string) (*listing, error) {
+	cache, err := ioutil.TempDir(storageDir("tmp"), "list")
+	if err != nil {
+		return nil, err
+	}
+	defer removeCache(cache)
+
+	out, err1 := runGo(cache, module, "list", "-m", "-json", "-versions", module+"@latest")
+	if err1 != nil {
+		return nil, err1
+	}
+
+	l := &listing{}
+	if err2 := json.Unmarshal(out, l); err2 != nil {
+		return nil, err2
+	}
+
+	return l, nil
+}
+
+// Runs go tool with passed module cache. Returns what go tool wrote to
+// stdout, error's message is what it wrote to stderr.
+func runGo(cache string, module string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+	defer cancel()
+
+	binary := config.Config.Proxy.GoBinary
+	if binary == "" {
+		binary = "go"
+	}
+
+	upstream := config.Config.Proxy.Upstream
+	if upstream == "" {
+		upstream = "direct"
+	}
+
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Dir = cache
+	// Environment is inherited for git credentials and SSH agent, go
+	// tool's own settings are overridden. Served modules are private,
+	// so there is nothing to check in public checksum database.
+	cmd.Env = append(os.Environ(),
+		"GO111MODULE=on",
+		"GOFLAGS=",
+		"GOWORK=off",
+		"GOTOOLCHAIN=local",
+		"GOPROXY="+upstream,
+		"GOSUMDB=off",
+		"GOPATH="+filepath.Join(cache, "gopath"),
+		"GOMODCACHE="+filepath.Join(cache, "mod"),
+	)
+
+	// Direct fetch asks MAGISTER itself for go-import meta tag.
+	if strings.HasPrefix(config.Config.HTTP.Domain, "http://") {
+		cmd.Env = append(cmd.Env, "GOINSECURE="+module)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+
+		return out, errors.New(message)
+	}
+
+	return out, nil
+}
+
+// Removes temporary module cache. Go tool makes extracted modules read
+// only, so they're made writable first.
+func removeCache(cache string) {
+	filepath.Walk(cache, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.IsDir() {
+			os.Chmod(path, 0755)
+		}
+
+		return nil
+	})
+
+	if err := os.RemoveAll(cache); err != nil {
+		log.Error().Msgf("Failed to remove temporary module cache '%s': %s", cache, err.Error())
+	}
+}
+
+// Returns path of passed storage's subdirectory, creating it if needed.
+func storageDir(name string) string {
+	dir := filepath.Join(config.Config.Proxy.StoragePath, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Error().Msgf("Failed to create proxy storage directory '%s': %s", dir, err.Error())
+	}
+
+	return dir
+}
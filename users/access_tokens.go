@@ -0,0 +1,259 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"errors"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Access tokens scopes.
+const (
+	// Read packages, including own drafts thru go get.
+	ScopePackagesRead = "packages:read"
+	// Read and manage packages.
+	ScopePackagesWrite = "packages:write"
+	// Everything user's role allows.
+	ScopeAdmin = "admin"
+)
+
+// Scopes is a list of all access tokens scopes.
+var Scopes = []string{ScopePackagesRead, ScopePackagesWrite, ScopeAdmin}
+
+// Which permissions scopes give. Token never gives more than user's
+// role allows.
+var scopePermissions = map[string][]string{
+	ScopePackagesRead:  {PermPackagesView},
+	ScopePackagesWrite: {PermPackagesView, PermPackagesManage},
+	ScopeAdmin:         {PermDashboardView, PermPackagesView, PermPackagesManage, PermWebhooksManage, PermAuditView, PermUsersManage, PermSettingsManage},
+}
+
+// Access tokens are easy to recognize (e.g. by secret scanners) by this
+// prefix.
+const accessTokenPrefix = "mag_"
+
+// Last usage time isn't updated more often than this, to not write
+// into database on every request.
+const lastUsedPrecision = time.Minute
+
+// AccessToken is a personal access token which tools use instead of
+// session cookie. Only token's hash is stored. ExpiresAt is nil for
+// tokens which never expire, LastUsedAt is nil if token was never used.
+type AccessToken struct {
+	ID         int        `db:"id"`
+	UserID     int        `db:"user_id"`
+	Name       string     `db:"name"`
+	TokenHash  string     `db:"token_hash"`
+	Scopes     string     `db:"scopes"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// IsValidScope returns true if passed scope is known.
+func IsValidScope(scope string) bool {
+	_, known := scopePermissions[scope]
+	return known
+}
+
+// CreateAccessToken creates token with passed name, scopes and validity
+// (zero means token never expires) and returns token's value, which
+// can't be obtained later.
+func (u *User) CreateAccessToken(name string, scopes []string, validity time.Duration) (*AccessToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("name should not be empty")
+	}
+
+	if len(name) > 128 {
+		return nil, "", errors.New("name should not be longer than 128 characters")
+	}
+
+	if len(scopes) == 0 {
+		return nil, "", errors.New("at least one scope should be selected")
+	}
+
+	for _, scope := range scopes {
+		if !IsValidScope(scope) {
+			return nil, "", errors.New("unknown scope '" + scope + "'")
+		}
+	}
+
+	random, err := helpers.GenerateToken(20)
+	if err != nil {
+		return nil, "", err
+	}
+	token := accessTokenPrefix + random
+
+	t := &AccessToken{
+		UserID:    u.ID,
+		Name:      name,
+		TokenHash: helpers.HashToken(token),
+		Scopes:    strings.Join(scopes, ","),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if validity != 0 {
+		expiresAt := t.CreatedAt.Add(validity)
+		t.ExpiresAt = &expiresAt
+	}
+
+	res, err1 := database.DB.NamedExec("INSERT INTO `users_access_tokens` (user_id, name, token_hash, scopes, expires_at, created_at) VALUES (:user_id, :name, :token_hash, :scopes, :expires_at, :created_at)", t)
+	if err1 != nil {
+		return nil, "", err1
+	}
+
+	lastInsertedID, err2 := res.LastInsertId()
+	if err2 != nil {
+		return nil, "", err2
+	}
+
+	t.ID = int(lastInsertedID)
+	return t, token, nil
+}
+
+// GetAccessTokens returns user's access tokens, newest first.
+func (u *User) GetAccessTokens() []*AccessToken {
+	tokens := []*AccessToken{}
+	err := database.DB.Select(&tokens, database.DB.Rebind("SELECT * FROM `users_access_tokens` WHERE user_id=? ORDER BY id DESC"), u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to get access tokens of user #%d: %s", u.ID, err.Error())
+		return nil
+	}
+
+	return tokens
+}
+
+// GetAccessToken returns user's access token with passed ID.
+func (u *User) GetAccessToken(id int) *AccessToken {
+	t := &AccessToken{}
+	err := database.DB.Get(t, database.DB.Rebind("SELECT * FROM `users_access_tokens` WHERE id=? AND user_id=?"), id, u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to get access token #%d of user #%d: %s", id, u.ID, err.Error())
+		return nil
+	}
+
+	return t
+}
+
+// Revoke deletes access token.
+func (t *AccessToken) Revoke() error {
+	_, err := database.DB.NamedExec("DELETE FROM `users_access_tokens` WHERE id=:id", t)
+	return err
+}
+
+// IsExpired returns true if token has expired.
+func (t *AccessToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().UTC().After(*t.ExpiresAt)
+}
+
+// ScopesList returns token's scopes.
+func (t *AccessToken) ScopesList() []string {
+	return strings.Split(t.Scopes, ",")
+}
+
+// Allows returns true if token's scopes give passed permission.
+func (t *AccessToken) Allows(permission string) bool {
+	for _, scope := range t.ScopesList() {
+		for _, p := range scopePermissions[scope] {
+			if p == permission {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AuditData returns token's fields which are written into audit log.
+func (t *AccessToken) AuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"name":       t.Name,
+		"scopes":     t.Scopes,
+		"expires_at": t.ExpiresAt,
+	}
+}
+
+// Remembers that token was just used.
+func (t *AccessToken) touch() {
+	now := time.Now().UTC()
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < lastUsedPrecision {
+		return
+	}
+
+	t.LastUsedAt = &now
+	if _, err := database.DB.NamedExec("UPDATE `users_access_tokens` SET last_used_at=:last_used_at WHERE id=:id", t); err != nil {
+		log.Error().Msgf("Failed to update last usage time of access token #%d: %s", t.ID, err.Error())
+	}
+}
+
+// Checks access token passed with request. Token used for request is
+// kept in context, so permissions might be limited by it's scopes.
+func checkAccessToken(ec echo.Context, token string) (int, bool) {
+	if !strings.HasPrefix(token, accessTokenPrefix) {
+		return 0, false
+	}
+
+	t := &AccessToken{}
+	err := database.DB.Get(t, database.DB.Rebind("SELECT * FROM `users_access_tokens` WHERE token_hash=?"), helpers.HashToken(token))
+	if err != nil {
+		log.Debug().Msgf("Access token wasn't found: %s", err.Error())
+		return 0, false
+	}
+
+	if t.IsExpired() {
+		log.Debug().Msgf("Access token #%d has expired", t.ID)
+		return 0, false
+	}
+
+	u := GetUserByID(t.UserID)
+	if u == nil || !u.IsActive {
+		return 0, false
+	}
+
+	t.touch()
+	ec.Set("ACCESS_TOKEN", t)
+	return u.ID, true
+}
+
+// CurrentAccessToken returns access token current request was
+// authorized with, or nil if it was authorized with session cookie.
+func CurrentAccessToken(ec echo.Context) *AccessToken {
+	t, _ := ec.Get("ACCESS_TOKEN").(*AccessToken)
+	return t
+}
+
+// Deletes all user's access tokens.
+func (u *User) deleteAccessTokens() error {
+	_, err := database.DB.NamedExec("DELETE FROM `users_access_tokens` WHERE user_id=:id", u)
+	return err
+}
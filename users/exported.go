@@ -33,12 +33,24 @@ import (
 func Initialize() {
 	log.Info().Msg("Initializing 'users' module...")
 
+	// Tools authorize with personal access tokens.
+	http.SetTokenChecker(checkAccessToken)
+
+	// Users which must change password can't go anywhere else.
+	http.E.Use(passwordChangeEnforcer())
+	// Users which role requires two-factor authentication can't go
+	// anywhere else until they set it up.
+	http.E.Use(twoFactorEnforcer())
+
 	// Template actions.
 	templater.RegisterTemplateName("user.name", GetCurrentlyLoggedInUserName)
 
 	// Login.
 	http.E.GET("/login/", loginGET)
 	http.E.POST("/login/", loginPOST)
+	http.E.POST("/login/2fa/", loginTwoFactorPOST)
+	http.E.GET("/login/oidc/", oidcLoginGET)
+	http.E.GET("/login/oidc/callback/", oidcCallbackGET)
 
 	// Login required form.
 	http.E.GET("/login_required/", loginRequiredGET)
@@ -48,6 +60,19 @@ func Initialize() {
 	// Already logged out message.
 	http.E.GET("/already_logged_out/", alreadyLoggedOutGET)
 
+	// Self-service registration.
+	http.E.GET("/register/", registerGET)
+	http.E.POST("/register/", registerPOST)
+	http.E.GET("/activate/", activateGET)
+	http.E.GET("/resend_activation/", resendActivationGET)
+	http.E.POST("/resend_activation/", resendActivationPOST)
+
+	// Forgotten password reset.
+	http.E.GET("/reset_password/", resetPasswordGET)
+	http.E.POST("/reset_password/", resetPasswordPOST)
+	http.E.GET("/reset_password/confirm/", resetPasswordConfirmGET)
+	http.E.POST("/reset_password/confirm/", resetPasswordConfirmPOST)
+
 	// Logout.
 	http.E.GET("/logout/", logoutGET)
 
@@ -0,0 +1,282 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"math"
+	"strconv"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/database"
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/mailsender"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Every password check is expensive, so guessing is slowed down both
+// per account and per client's IP address. First failures are free,
+// then delay before next attempt doubles with every failure.
+const (
+	accountFreeFailures = 3
+	accountMaxDelay     = 30 * time.Second
+
+	ipFreeFailures = 5
+	ipMaxDelay     = 5 * time.Minute
+	// IP address failures are forgotten after this long without them.
+	ipFailuresMemory = time.Hour
+
+	loginBaseDelay = time.Second
+)
+
+var loginIPBackoff = newBackoff(ipFreeFailures, loginBaseDelay, ipMaxDelay, ipFailuresMemory)
+
+// IsLocked returns true if account is locked after too many failed
+// logins in a row.
+func (u *User) IsLocked() bool {
+	return u.LockedUntil != nil && time.Now().UTC().Before(*u.LockedUntil)
+}
+
+// Returns how long login attempts for user are refused: until lock
+// expires, or until backoff delay after last failure passes.
+func (u *User) loginDelay() time.Duration {
+	now := time.Now().UTC()
+	if u.IsLocked() {
+		return u.LockedUntil.Sub(now)
+	}
+
+	// Expired lock means counting starts over.
+	if u.LockedUntil != nil || u.LastFailedLoginAt == nil {
+		return 0
+	}
+
+	wait := backoffDelay(u.FailedLogins, accountFreeFailures, loginBaseDelay, accountMaxDelay) - now.Sub(*u.LastFailedLoginAt)
+	if wait < 0 {
+		return 0
+	}
+
+	return wait
+}
+
+// Reserves login attempt before password is checked. Attempt counts as
+// failed until password is checked, so concurrent requests can't all pass
+// delay check and run expensive password checks in parallel: counter is
+// changed only if nobody else changed it since user was read. Returns
+// how long to wait if attempt isn't allowed now.
+func (u *User) reserveLoginAttempt() time.Duration {
+	if delay := u.loginDelay(); delay > 0 {
+		return delay
+	}
+
+	now := time.Now().UTC()
+	failures := u.FailedLogins + 1
+	// Expired lock means counting starts over.
+	if u.LockedUntil != nil {
+		failures = 1
+	}
+
+	res, err := database.DB.Exec(database.DB.Rebind("UPDATE `users` SET failed_logins=?, last_failed_login_at=?, locked_until=NULL WHERE id=? AND failed_logins=? AND (locked_until IS NULL OR locked_until<=?)"), failures, now, u.ID, u.FailedLogins, now)
+	if err != nil {
+		log.Error().Msgf("Failed to reserve login attempt for user '%s': %s", u.Login, err.Error())
+		return loginBaseDelay
+	}
+
+	// Concurrent attempt was faster.
+	if affected, _ := res.RowsAffected(); affected != 1 {
+		return loginBaseDelay
+	}
+
+	u.FailedLogins = failures
+	u.LastFailedLoginAt = &now
+	u.LockedUntil = nil
+
+	return 0
+}
+
+// Returns reserved login attempt which turned out not to be a password
+// failure. Concurrent attempts might have changed counter since it was
+// reserved, so it's decremented by database.
+func (u *User) releaseLoginAttempt() {
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `users` SET failed_logins=failed_logins-1 WHERE id=? AND failed_logins>0"), u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to release login attempt of user '%s': %s", u.Login, err.Error())
+		return
+	}
+
+	if u.FailedLogins > 0 {
+		u.FailedLogins--
+	}
+}
+
+// Records failed login which wasn't reserved (e.g. invalid second
+// factor) and locks account if there were too many of them. Counter is
+// incremented by database, so concurrent attempts can't bypass the
+// limit. Returns true if account was locked by this failure.
+func (u *User) registerFailedLogin() bool {
+	now := time.Now().UTC()
+
+	_, err := database.DB.Exec(database.DB.Rebind("UPDATE `users` SET failed_logins=IF(locked_until IS NOT NULL AND locked_until<=?, 1, failed_logins+1), locked_until=IF(locked_until IS NOT NULL AND locked_until<=?, NULL, locked_until), last_failed_login_at=? WHERE id=?"), now, now, now, u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to record failed login of user '%s': %s", u.Login, err.Error())
+		return false
+	}
+
+	if err1 := database.DB.Get(u, database.DB.Rebind("SELECT * FROM `users` WHERE id=?"), u.ID); err1 != nil {
+		log.Error().Msgf("Failed to get user '%s': %s", u.Login, err1.Error())
+		return false
+	}
+
+	return u.lockIfTooManyFailures()
+}
+
+// Locks account if it has too many failed logins in a row. Returns true
+// if account was locked by this call.
+func (u *User) lockIfTooManyFailures() bool {
+	if u.FailedLogins < settings.Int("security.lockout_failures") || u.LockedUntil != nil {
+		return false
+	}
+
+	until := time.Now().UTC().Add(time.Minute * time.Duration(settings.Int("security.lockout_minutes")))
+	res, err := database.DB.Exec(database.DB.Rebind("UPDATE `users` SET locked_until=? WHERE id=? AND locked_until IS NULL"), until, u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to lock user '%s': %s", u.Login, err.Error())
+		return false
+	}
+
+	// Other concurrent failure might have locked it already.
+	if affected, _ := res.RowsAffected(); affected != 1 {
+		return false
+	}
+
+	u.LockedUntil = &until
+	return true
+}
+
+// Forgets failed logins after successful one.
+func (u *User) resetFailedLogins() {
+	if u.FailedLogins == 0 && u.LockedUntil == nil {
+		return
+	}
+
+	u.Unlock()
+}
+
+// Unlock unlocks account and forgets its failed logins.
+func (u *User) Unlock() {
+	u.FailedLogins = 0
+	u.LastFailedLoginAt = nil
+	u.LockedUntil = nil
+
+	_, err := database.DB.NamedExec("UPDATE `users` SET failed_logins=:failed_logins, last_failed_login_at=:last_failed_login_at, locked_until=:locked_until WHERE id=:id", u)
+	if err != nil {
+		log.Error().Msgf("Failed to unlock user '%s': %s", u.Login, err.Error())
+	}
+}
+
+// Returns human-readable description of how long to wait.
+func waitDescription(delay time.Duration) string {
+	if delay < 2*time.Minute {
+		return "in " + strconv.Itoa(int(math.Ceil(delay.Seconds()))) + " seconds"
+	}
+
+	return "in " + strconv.Itoa(int(math.Ceil(delay.Minutes()))) + " minutes"
+}
+
+// Reserves login attempt of client which makes current request for
+// passed user (nil if it's unknown) before password is checked. Returns
+// how long client should wait if attempt isn't allowed now.
+func reserveLoginAttempt(ec echo.Context, u *User) time.Duration {
+	ip := h.ClientIP(ec.Request()).String()
+	if delay := loginIPBackoff.Reserve(ip); delay > 0 {
+		return delay
+	}
+
+	if u != nil {
+		if delay := u.reserveLoginAttempt(); delay > 0 {
+			loginIPBackoff.Release(ip)
+			return delay
+		}
+	}
+
+	return 0
+}
+
+// Settles attempt reserved by reserveLoginAttempt for passed user (nil
+// if it was unknown) after credentials were checked with passed result.
+// Only wrong credentials keep attempt counted as failed, e.g. user which
+// provider doesn't allow or provider's failure aren't guessing.
+func settleLoginAttempt(ec echo.Context, u *User, err error) {
+	if err == errInvalidCredentials {
+		loginAttemptFailed(ec, u)
+		return
+	}
+
+	releaseLoginAttempt(ec, u)
+}
+
+// Returns attempt reserved for client's IP address and passed user.
+// Failed second factor checks are counted separately.
+func releaseLoginAttempt(ec echo.Context, u *User) {
+	loginIPBackoff.Release(h.ClientIP(ec.Request()).String())
+
+	if u != nil {
+		u.releaseLoginAttempt()
+	}
+}
+
+// Keeps reserved attempt as failed after invalid credentials were
+// passed and locks account if there were too many failures.
+func loginAttemptFailed(ec echo.Context, u *User) {
+	if u != nil && u.lockIfTooManyFailures() {
+		accountLocked(ec, u)
+	}
+}
+
+// Records failed second factor check. It counts towards account lock
+// like invalid password does.
+func twoFactorAttemptFailed(ec echo.Context, u *User) {
+	if u.registerFailedLogin() {
+		accountLocked(ec, u)
+	}
+}
+
+// Writes lock into audit log and notifies user by email.
+func accountLocked(ec echo.Context, u *User) {
+	log.Warn().Msgf("User '%s' was locked after %d failed logins", u.Login, u.FailedLogins)
+	audit.Log(ec, &audit.Event{Action: audit.ActionUserLock, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, map[string]interface{}{
+		"failed_logins": u.FailedLogins,
+		"locked_until":  u.LockedUntil,
+	})
+
+	mailsender.SendMail("mail/account_locked.txt", map[string]string{
+		"mail.to":      u.Email,
+		"mail.subject": "Your account is temporarily locked",
+		"user.login":   u.Login,
+		"lock.until":   u.LockedUntil.Format("2006-01-02 15:04"),
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Returns context of login request from passed IP address.
+func loginContext(ip string) echo.Context {
+	req := httptest.NewRequest(http.MethodPost, "/login/", nil)
+	req.RemoteAddr = ip + ":40000"
+
+	ec := echo.New().NewContext(req, httptest.NewRecorder())
+	ec.Set("AUTHORIZED", false)
+
+	return ec
+}
+
+// Returns how many attempts are counted as failed for IP address.
+func ipFailures(ip string) int {
+	loginIPBackoff.failuresMutex.Lock()
+	defer loginIPBackoff.failuresMutex.Unlock()
+
+	if state, found := loginIPBackoff.failures[ip]; found {
+		return state.count
+	}
+
+	return 0
+}
+
+// Attempts of unknown users are reserved only for IP address, so none
+// of these cases reach database.
+func TestSettleLoginAttempt(t *testing.T) {
+	config.Config = &config.Configuration{}
+	settings.Reload()
+
+	cases := []struct {
+		name   string
+		ip     string
+		err    error
+		failed int
+	}{
+		{"valid credentials", "192.0.2.1", nil, 0},
+		{"user not allowed by provider", "192.0.2.2", errNotAllowed, 0},
+		{"provider failure", "192.0.2.3", errors.New("LDAP server is unavailable"), 0},
+		{"wrong credentials", "192.0.2.4", errInvalidCredentials, 1},
+	}
+
+	for _, c := range cases {
+		ec := loginContext(c.ip)
+		if delay := reserveLoginAttempt(ec, nil); delay != 0 {
+			t.Fatalf("Expected first attempt from %s to be allowed, got delay %s", c.ip, delay)
+		}
+
+		if failures := ipFailures(c.ip); failures != 1 {
+			t.Errorf("Expected reserved attempt to be counted for %s, got %d", c.name, failures)
+		}
+
+		settleLoginAttempt(ec, nil, c.err)
+
+		if failures := ipFailures(c.ip); failures != c.failed {
+			t.Errorf("Expected %d failed attempts after %s, got %d", c.failed, c.name, failures)
+		}
+	}
+}
+
+// Attempts which aren't password failures never slow client down,
+// wrong passwords do after free failures.
+func TestSettleLoginAttemptBackoff(t *testing.T) {
+	config.Config = &config.Configuration{}
+	settings.Reload()
+
+	for _, err := range []error{nil, errNotAllowed, errors.New("identity provider failed")} {
+		ec := loginContext("198.51.100.1")
+		for i := 0; i < ipFreeFailures*2; i++ {
+			if delay := reserveLoginAttempt(ec, nil); delay != 0 {
+				t.Fatalf("Expected attempt #%d settled with '%v' not to be delayed, got %s", i+1, err, delay)
+			}
+			settleLoginAttempt(ec, nil, err)
+		}
+	}
+
+	ec := loginContext("198.51.100.2")
+	for i := 0; i <= ipFreeFailures; i++ {
+		if delay := reserveLoginAttempt(ec, nil); delay != 0 {
+			t.Fatalf("Expected free attempt #%d not to be delayed, got %s", i+1, delay)
+		}
+		settleLoginAttempt(ec, nil, errInvalidCredentials)
+	}
+
+	if delay := reserveLoginAttempt(ec, nil); delay == 0 {
+		t.Error("Expected attempt after too many wrong passwords to be delayed")
+	}
+}
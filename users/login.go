@@ -23,13 +23,19 @@ package users
 
 import (
 	// stdlib
+	"html"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	// local
+	"github.com/welltrainedfolks/magister/audit"
 	"github.com/welltrainedfolks/magister/internal/config"
+	h "github.com/welltrainedfolks/magister/internal/http"
 	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/settings"
 	"github.com/welltrainedfolks/magister/internal/templater"
 
 	// other
@@ -48,8 +54,10 @@ func loginGET(ec echo.Context) error {
 	}
 
 	htmlData := templater.GetTemplate(ec, "users/login.html", map[string]string{
-		"login":     "",
-		"errorsDiv": "",
+		"login":        "",
+		"errorsDiv":    "",
+		"registration": registrationLink(ec),
+		"sso":          ssoLink(ec),
 	})
 
 	return ec.HTML(http.StatusOK, htmlData)
@@ -80,72 +88,179 @@ func loginPOST(ec echo.Context) error {
 		errors = append(errors, "Password should not be empty.")
 	}
 
+	// User might not exist yet if external provider creates it.
 	var u *User
 	if req.Login != "" {
-		var userFound bool
 		u = GetUser(req.Login)
-		if u != nil {
-			userFound = true
-		}
-
-		if !userFound {
+		if u == nil {
 			u = GetUserByLogin(req.Login)
-			if u != nil {
-				userFound = true
-			}
 		}
+	}
 
-		if !userFound {
-			log.Error().Msgf("User with login or email '%s' wasn't found", req.Login)
-			errors = append(errors, "Invalid login or password.")
+	// Password checks are expensive, so clients which failed too many
+	// times have to wait before they're checked again. Attempt is
+	// reserved before password is checked, so parallel requests don't
+	// get around it. Refused attempts aren't written into audit log,
+	// otherwise flood would fill it.
+	if len(errors) == 0 {
+		if delay := reserveLoginAttempt(ec, u); delay > 0 {
+			log.Warn().Msgf("Login attempt for '%s' from %s was throttled for %s", req.Login, h.ClientIP(ec.Request()), delay)
+			ec.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			return ec.HTML(http.StatusTooManyRequests, templater.GetTemplate(ec, "users/login.html", map[string]string{
+				"login":        html.EscapeString(req.Login),
+				"errorsDiv":    templater.GetErrorFlash(ec, []string{"Too many failed login attempts, please try again " + waitDescription(delay) + "."}),
+				"registration": registrationLink(ec),
+				"sso":          ssoLink(ec),
+			}))
 		}
 	}
 
-	var passwordValid bool
-	if u != nil && !u.CheckPassword(req.Password) {
-		log.Error().Msgf("Invalid password for user '%s'", req.Login)
-		errors = append(errors, "Invalid login or password.")
-	} else if u != nil && u.CheckPassword(req.Password) {
-		passwordValid = true
+	failReason := "invalid login or password"
+	if len(errors) == 0 {
+		reserved := u
+		var err error
+		u, err = authenticate(ec, u, req.Login, req.Password)
+		settleLoginAttempt(ec, reserved, err)
+
+		if err != nil {
+			switch err {
+			case errInvalidCredentials:
+				log.Error().Msgf("Invalid login or password for '%s'", req.Login)
+				errors = append(errors, "Invalid login or password.")
+			case errNotAllowed:
+				log.Error().Msgf("User '%s' isn't allowed to log in", req.Login)
+				errors = append(errors, "You aren't allowed to log in here.")
+				failReason = "not allowed by authentication provider"
+			default:
+				log.Error().Msgf("Failed to authenticate '%s': %s", req.Login, err.Error())
+				errors = append(errors, "Failed to check login and password, please try again later.")
+				failReason = "authentication provider failed"
+			}
+		}
 	}
 
 	if len(errors) != 0 {
 		log.Warn().Msg("Some errors were detected, showing login dialog with error messages")
+		if req.Login != "" {
+			loginFailed(ec, req.Login, u, failReason)
+		}
+
 		registerData := map[string]string{
-			"login":     req.Login,
-			"errorsDiv": templater.GetErrorFlash(ec, errors),
+			"login":        html.EscapeString(req.Login),
+			"errorsDiv":    templater.GetErrorFlash(ec, errors),
+			"registration": registrationLink(ec),
+			"sso":          ssoLink(ec),
 		}
 		registerTpl := templater.GetTemplate(ec, "users/login.html", registerData)
 
 		return ec.HTML(http.StatusBadRequest, registerTpl)
 	}
 
-	// Check provided data.
-	var tpl string
-	if u != nil && passwordValid {
-		log.Debug().Msg("User exists and password is valid")
-		// Check if user is activated.
-		if !u.IsActive {
-			tpl = templater.GetTemplate(ec, "users/login_failed_not_activated.html", nil)
-			return ec.HTML(http.StatusOK, tpl)
-		}
-		log.Debug().Msg("Valid credentials, sending authorization cookies")
+	return completeLogin(ec, u, req.Login)
+}
+
+// Finishes login of user which was authenticated by provider: inactive
+// users are refused and users with two-factor authentication are asked
+// for second factor before session key is issued.
+func completeLogin(ec echo.Context, u *User, login string) error {
+	log.Debug().Msg("User was authenticated")
+
+	if !u.IsActive {
+		loginFailed(ec, login, u, "user isn't activated")
+		tpl := templater.GetTemplate(ec, "users/login_failed_not_activated.html", map[string]string{"reason": notActivatedReason(u)})
+		return ec.HTML(http.StatusOK, tpl)
+	}
+
+	if u.TOTPEnabled {
+		return twoFactorChallenge(ec, u)
+	}
+
+	return startSession(ec, u)
+}
+
+// Issues session key for user which passed all authentication steps.
+func startSession(ec echo.Context, u *User) error {
+	log.Debug().Msg("Valid credentials, sending authorization cookies")
+
+	key := sessionkeys.NewSessionKey(u.ID, h.ClientIP(ec.Request()).String(), ec.Request().UserAgent())
+	setSessionCookie(ec, key, time.Now().UTC())
 
-		key := sessionkeys.NewSessionKey(u.ID)
+	u.resetFailedLogins()
+	u.UpdateLastLogin()
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionLogin, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, nil)
 
-		cookieKey := new(http.Cookie)
-		cookieKey.Name = "s3ss1onk3y"
-		cookieKey.Value = key
-		cookieKey.Expires = time.Now().UTC().Add(time.Hour * time.Duration(24*config.Config.HTTP.SessionValidityDays))
-		cookieKey.Domain = strings.Split(strings.Split(config.Config.HTTP.Domain, "/")[2], ":")[0]
-		cookieKey.Path = "/"
-		log.Debug().Msgf("Cookie prepared: %+v", cookieKey)
-		ec.SetCookie(cookieKey)
+	if u.MustChangePassword {
+		return ec.Redirect(http.StatusFound, passwordChangePath)
+	}
+
+	return ec.HTML(http.StatusOK, templater.GetTemplate(ec, "users/login_success.html", nil))
+}
+
+// Sends session key to client. Cookie expires when session reaches
+// absolute timeout.
+func setSessionCookie(ec echo.Context, key string, createdAt time.Time) {
+	cookieKey := new(http.Cookie)
+	cookieKey.Name = "s3ss1onk3y"
+	cookieKey.Value = key
+	cookieKey.Expires = createdAt.Add(time.Hour * time.Duration(24*settings.Int("http.session_validity_days")))
+	cookieKey.Domain = strings.Split(strings.Split(config.Config.HTTP.Domain, "/")[2], ":")[0]
+	cookieKey.Path = "/"
+	cookieKey.HttpOnly = true
+	log.Debug().Msgf("Cookie prepared: %+v", cookieKey)
+	ec.SetCookie(cookieKey)
+}
+
+// Replaces current session's key after user's privileges were changed
+// (e.g. password was changed or two-factor authentication enabled), so
+// key which might have leaked before doesn't give new privileges.
+func rotateSessionKey(ec echo.Context) {
+	s := currentSession(ec)
+	c, err := ec.Cookie("s3ss1onk3y")
+	if s == nil || err != nil {
+		return
+	}
+
+	if key := sessionkeys.RotateSessionKey(c.Value); key != "" {
+		setSessionCookie(ec, key, s.CreatedAt)
+	}
+}
+
+// Returns session current request was made in, or nil if request was
+// authorized otherwise (e.g. with access token).
+func currentSession(ec echo.Context) *sessionkeys.Session {
+	s, _ := ec.Get("SESSION").(*sessionkeys.Session)
+	return s
+}
+
+// Returns link to registration form if registration is enabled.
+func registrationLink(ec echo.Context) string {
+	if !settings.Bool("registration.enabled") {
+		return ""
+	}
+
+	return templater.GetRawTemplate(ec, "users/registration_link.html", nil)
+}
+
+// Explains why user which isn't active can't log in.
+func notActivatedReason(u *User) string {
+	switch u.RegistrationState {
+	case RegistrationAwaitingEmail:
+		return "Please, activate your account by following link from mail that was sent to your e-mail. If mail was lost - <a href=\"/resend_activation/\">request new one</a>."
+	case RegistrationAwaitingApproval:
+		return "Your registration awaits administrator's approval."
+	}
+
+	return "Your account was deactivated by administrator."
+}
 
-		tpl = templater.GetTemplate(ec, "users/login_success.html", nil)
-	} else {
-		log.Error().Msg("Invalid credentials passed")
+// Writes failed login attempt into audit log. User is nil if nobody has
+// passed login or email.
+func loginFailed(ec echo.Context, login string, u *User, reason string) {
+	event := &audit.Event{Action: audit.ActionLoginFailed, TargetType: audit.TargetUser, Target: login}
+	if u != nil {
+		event.TargetID = u.ID
+		event.Target = u.Login
 	}
 
-	return ec.HTML(http.StatusOK, tpl)
+	audit.Log(ec, event, nil, map[string]string{"reason": reason})
 }
@@ -0,0 +1,218 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"crypto/subtle"
+	"errors"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/oidc"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// Where identity provider sends user back.
+	oidcCallbackPath = "/login/oidc/callback/"
+	// Cookie which keeps state, nonce and PKCE code verifier while
+	// user is at identity provider.
+	oidcCookie = "magoidc"
+	// How long user might stay at identity provider.
+	oidcLoginValidity = 10 * time.Minute
+)
+
+// Returns "Sign in with" button if OpenID Connect login is enabled.
+func ssoLink(ec echo.Context) string {
+	if !(&oidcProvider{}).Enabled() {
+		return ""
+	}
+
+	return templater.GetRawTemplate(ec, "users/sso_link.html", map[string]string{
+		"sso.label": html.EscapeString(settings.String("oidc.button_label")),
+	})
+}
+
+// Shows page for failed OpenID Connect login.
+func oidcLoginFailed(ec echo.Context, status int, message string) error {
+	return noticePage(ec, status, "Login failed", message)
+}
+
+// Returns cookie for OpenID Connect login state.
+func oidcStateCookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcCookie,
+		Value:    value,
+		Path:     oidcCallbackPath,
+		MaxAge:   maxAge,
+		Secure:   strings.HasPrefix(config.Config.HTTP.Domain, "https://"),
+		HttpOnly: true,
+	}
+}
+
+// Sends user to identity provider.
+func oidcLoginGET(ec echo.Context) error {
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	if !(&oidcProvider{}).Enabled() {
+		return h.NotFoundGET(ec)
+	}
+
+	p, err := oidc.Discover(settings.String("oidc.issuer"))
+	if err != nil {
+		log.Error().Msgf("Failed to discover OpenID Connect provider: %s", err.Error())
+		return oidcLoginFailed(ec, http.StatusBadGateway, "Identity provider is unavailable, please try again later.")
+	}
+
+	// State, nonce and PKCE code verifier.
+	var values []string
+	for i := 0; i < 3; i++ {
+		value, err1 := oidc.GenerateState()
+		if err1 != nil {
+			log.Error().Msgf("Failed to generate OpenID Connect state: %s", err1.Error())
+			return oidcLoginFailed(ec, http.StatusInternalServerError, "Login failed, please try again later.")
+		}
+		values = append(values, value)
+	}
+
+	ec.SetCookie(oidcStateCookie(strings.Join(values, "."), int(oidcLoginValidity/time.Second)))
+
+	authURL := p.AuthCodeURL(settings.String("oidc.client_id"), absoluteURL(oidcCallbackPath, nil), settings.List("oidc.scopes"), values[0], values[1], values[2])
+	return ec.Redirect(http.StatusFound, authURL)
+}
+
+// Handles user which came back from identity provider.
+func oidcCallbackGET(ec echo.Context) error {
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	if !(&oidcProvider{}).Enabled() {
+		return h.NotFoundGET(ec)
+	}
+
+	// State is single-use.
+	var values []string
+	if cookie, err := ec.Cookie(oidcCookie); err == nil {
+		values = strings.Split(cookie.Value, ".")
+	}
+	ec.SetCookie(oidcStateCookie("", -1))
+
+	if e := ec.QueryParam("error"); e != "" {
+		log.Warn().Msgf("OpenID Connect provider returned error '%s': %s", e, ec.QueryParam("error_description"))
+		return oidcLoginFailed(ec, http.StatusForbidden, "Identity provider refused to log you in. <a href=\"/login/\">Back to login</a>.")
+	}
+
+	if len(values) != 3 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(ec.QueryParam("state"))) != 1 {
+		return oidcLoginFailed(ec, http.StatusBadRequest, "Login took too long or was started in other browser, please <a href=\"/login/\">try again</a>.")
+	}
+	nonce, verifier := values[1], values[2]
+
+	p, err := oidc.Discover(settings.String("oidc.issuer"))
+	if err != nil {
+		log.Error().Msgf("Failed to discover OpenID Connect provider: %s", err.Error())
+		return oidcLoginFailed(ec, http.StatusBadGateway, "Identity provider is unavailable, please try again later.")
+	}
+
+	clientID := settings.String("oidc.client_id")
+	rawIDToken, err1 := p.Exchange(clientID, settings.String("oidc.client_secret"), absoluteURL(oidcCallbackPath, nil), ec.QueryParam("code"), verifier)
+	if err1 != nil {
+		log.Error().Msgf("Failed to exchange OpenID Connect authorization code: %s", err1.Error())
+		return oidcLoginFailed(ec, http.StatusBadGateway, "Failed to get your identity from identity provider, please <a href=\"/login/\">try again</a>.")
+	}
+
+	claims, err2 := p.Verify(rawIDToken, clientID, nonce)
+	if err2 != nil {
+		log.Error().Msgf("Failed to verify OpenID Connect ID token: %s", err2.Error())
+		return oidcLoginFailed(ec, http.StatusBadGateway, "Failed to get your identity from identity provider, please <a href=\"/login/\">try again</a>.")
+	}
+
+	identity, err3 := oidcIdentity(claims)
+	if err3 == errNotAllowed {
+		login := claims.String(settings.String("oidc.login_claim"))
+		log.Error().Msgf("User '%s' isn't in any mapped group", login)
+		loginFailed(ec, login, nil, "not allowed by authentication provider")
+		return oidcLoginFailed(ec, http.StatusForbidden, "You aren't allowed to log in here.")
+	} else if err3 != nil {
+		log.Error().Msgf("Failed to map OpenID Connect claims: %s", err3.Error())
+		return oidcLoginFailed(ec, http.StatusForbidden, "Identity provider didn't give enough information about you.")
+	}
+
+	u, err4 := oidcUser(ec, identity)
+	if err4 != nil {
+		log.Error().Msgf("Failed to log in OpenID Connect user '%s': %s", identity.Login, err4.Error())
+		loginFailed(ec, identity.Login, u, err4.Error())
+		return oidcLoginFailed(ec, http.StatusForbidden, "You can't log in with identity provider, please use your login and password.")
+	}
+
+	return completeLogin(ec, u, identity.Login)
+}
+
+// Returns user for passed identity, user is created on first login.
+// Users are matched by issuer and subject: login claim is usually
+// editable at identity provider, so it only names user which is
+// created. Users of other providers with same login can't be taken
+// over.
+func oidcUser(ec echo.Context, identity *Identity) (*User, error) {
+	p := &oidcProvider{}
+
+	if u := GetUserByOIDCSubject(identity.Issuer, identity.Subject); u != nil {
+		syncUser(ec, u, identity)
+		return u, nil
+	}
+
+	u := GetUserByLogin(identity.Login)
+	if u == nil {
+		return provisionUser(ec, p, identity)
+	}
+
+	if u.AuthProvider != p.Name() {
+		return u, errors.New("login belongs to " + u.AuthProvider + " user")
+	}
+
+	// Users created before subjects were stored are bound to subject on
+	// first login, if identity provider knows them by same email.
+	if u.OIDCSubject != "" || identity.Email == "" || !strings.EqualFold(u.Email, identity.Email) {
+		return u, errors.New("login belongs to other OpenID Connect user")
+	}
+
+	u.OIDCIssuer = identity.Issuer
+	u.OIDCSubject = identity.Subject
+	u.Save()
+	log.Info().Msgf("User '%s' was bound to OpenID Connect subject '%s'", u.Login, u.OIDCSubject)
+
+	syncUser(ec, u, identity)
+	return u, nil
+}
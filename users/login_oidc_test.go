@@ -0,0 +1,290 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/oidc"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo"
+)
+
+// Identity provider which issues ID tokens with claims tests set.
+// Token endpoint checks code verifier against challenge from
+// authorization request.
+type testOIDCProvider struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newTestOIDCProvider(t *testing.T) *testOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate signing key: %s", err.Error())
+	}
+
+	tp := &testOIDCProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 tp.server.URL,
+			"authorization_endpoint": tp.server.URL + "/authorize",
+			"token_endpoint":         tp.server.URL + "/token",
+			"jwks_uri":               tp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": "test-key",
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		hash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "auth-code" || base64.RawURLEncoding.EncodeToString(hash[:]) != tp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, tp.claims)
+		token.Header["kid"] = "test-key"
+		signed, _ := token.SignedString(tp.key)
+		json.NewEncoder(w).Encode(map[string]string{"id_token": signed})
+	})
+
+	tp.server = httptest.NewServer(mux)
+	return tp
+}
+
+// Configures OpenID Connect login with passed provider. Database isn't
+// available in tests, so settings come from configuration only.
+func configureOIDC(issuer string) {
+	enabled := true
+	config.Config = &config.Configuration{}
+	config.Config.HTTP.Domain = "https://go.example.com"
+	config.Config.OIDC = config.OIDC{
+		Enabled:     &enabled,
+		Issuer:      issuer,
+		ClientID:    "magister",
+		RoleMapping: []string{"go-admins:admin", "developers:maintainer"},
+	}
+	settings.Reload()
+}
+
+// Returns context of anonymous request.
+func anonymousContext(method string, target string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+
+	ec := echo.New().NewContext(req, rec)
+	ec.Set("AUTHORIZED", false)
+
+	return ec, rec
+}
+
+// Starts login and returns state, nonce and code verifier from cookie.
+func startOIDCLogin(t *testing.T, tp *testOIDCProvider) []string {
+	ec, rec := anonymousContext(http.MethodGet, "/login/oidc/")
+	if err := oidcLoginGET(ec); err != nil {
+		t.Fatalf("Login failed: %s", err.Error())
+	}
+
+	if rec.Code != http.StatusFound {
+		t.Fatalf("Expected redirect to identity provider, got %d", rec.Code)
+	}
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil || !strings.HasPrefix(location.String(), tp.server.URL+"/authorize?") {
+		t.Fatalf("Unexpected redirect to '%s'", rec.Header().Get("Location"))
+	}
+
+	var values []string
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == oidcCookie {
+			values = strings.Split(cookie.Value, ".")
+		}
+	}
+
+	if len(values) != 3 {
+		t.Fatalf("Expected state cookie with state, nonce and verifier, got %v", values)
+	}
+
+	query := location.Query()
+	if query.Get("state") != values[0] || query.Get("nonce") != values[1] || query.Get("code_challenge_method") != "S256" {
+		t.Errorf("Authorization request doesn't match state cookie: %s", location.String())
+	}
+
+	if query.Get("redirect_uri") != "https://go.example.com"+oidcCallbackPath || query.Get("client_id") != "magister" {
+		t.Errorf("Unexpected client in authorization request: %s", location.String())
+	}
+
+	tp.challenge = query.Get("code_challenge")
+	return values
+}
+
+// Returns result of callback with passed state cookie and query.
+func oidcCallback(values []string, query string) *httptest.ResponseRecorder {
+	ec, rec := anonymousContext(http.MethodGet, oidcCallbackPath+"?"+query)
+	ec.Request().AddCookie(&http.Cookie{Name: oidcCookie, Value: strings.Join(values, ".")})
+	oidcCallbackGET(ec)
+
+	return rec
+}
+
+func TestOIDCCallbackRejectsState(t *testing.T) {
+	tp := newTestOIDCProvider(t)
+	defer tp.server.Close()
+	configureOIDC(tp.server.URL)
+
+	values := startOIDCLogin(t, tp)
+
+	if rec := oidcCallback(values, "code=auth-code&state=other"); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected wrong state to be rejected with 400, got %d", rec.Code)
+	}
+
+	if rec := oidcCallback(nil, "code=auth-code&state="+values[0]); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected callback without state cookie to be rejected with 400, got %d", rec.Code)
+	}
+
+	if rec := oidcCallback(values, "error=access_denied&state="+values[0]); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected provider's error to be shown with 403, got %d", rec.Code)
+	}
+}
+
+// Callback fails before user is looked up, so none of these cases reach
+// database.
+func TestOIDCCallbackRejectsIDToken(t *testing.T) {
+	tp := newTestOIDCProvider(t)
+	defer tp.server.Close()
+	configureOIDC(tp.server.URL)
+
+	valid := func(nonce string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   tp.server.URL,
+			"sub":   "248289761001",
+			"aud":   "magister",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": nonce,
+		}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(claims jwt.MapClaims)
+	}{
+		{"issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{"audience", func(c jwt.MapClaims) { c["aud"] = "other-client" }},
+		{"nonce", func(c jwt.MapClaims) { c["nonce"] = "other-nonce" }},
+		{"expiration", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+	}
+
+	for _, c := range cases {
+		values := startOIDCLogin(t, tp)
+		tp.claims = valid(values[1])
+		c.modify(tp.claims)
+
+		if rec := oidcCallback(values, "code=auth-code&state="+values[0]); rec.Code != http.StatusBadGateway {
+			t.Errorf("Expected ID token with bad %s to be rejected with 502, got %d", c.name, rec.Code)
+		}
+	}
+
+	// Valid ID token passes verification and fails only on claims
+	// mapping, because it has no login claim.
+	values := startOIDCLogin(t, tp)
+	tp.claims = valid(values[1])
+	if rec := oidcCallback(values, "code=auth-code&state="+values[0]); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected valid ID token without login to be rejected with 403, got %d", rec.Code)
+	}
+
+	// Code verifier from other login doesn't match challenge.
+	values = startOIDCLogin(t, tp)
+	tp.claims = valid(values[1])
+	other := startOIDCLogin(t, tp)
+	if rec := oidcCallback([]string{other[0], values[1], values[2]}, "code=auth-code&state="+other[0]); rec.Code != http.StatusBadGateway {
+		t.Errorf("Expected code exchange with wrong verifier to fail with 502, got %d", rec.Code)
+	}
+}
+
+func TestOIDCIdentity(t *testing.T) {
+	configureOIDC("https://id.example.com")
+
+	claims := oidc.Claims{
+		"iss":                "https://id.example.com",
+		"sub":                "248289761001",
+		"preferred_username": "jdoe",
+		"email":              "jdoe@example.com",
+		"email_verified":     true,
+		"groups":             []interface{}{"developers", "Go-Admins"},
+	}
+
+	identity, err := oidcIdentity(claims)
+	if err != nil {
+		t.Fatalf("Expected identity, got error: %s", err.Error())
+	}
+
+	if identity.Login != "jdoe" || identity.Email != "jdoe@example.com" || identity.Subject != "248289761001" || identity.Role != RoleAdmin {
+		t.Errorf("Unexpected identity: %+v", identity)
+	}
+
+	claims["groups"] = []interface{}{"designers"}
+	if _, err1 := oidcIdentity(claims); err1 != errNotAllowed {
+		t.Errorf("Expected user without mapped group to be not allowed, got %v", err1)
+	}
+
+	claims["groups"] = "developers"
+	claims["email_verified"] = false
+	if _, err2 := oidcIdentity(claims); err2 == nil {
+		t.Error("Expected unverified email to be rejected")
+	}
+
+	delete(claims, "sub")
+	claims["email_verified"] = true
+	if _, err3 := oidcIdentity(claims); err3 == nil {
+		t.Error("Expected ID token without subject to be rejected")
+	}
+}
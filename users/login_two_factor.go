@@ -0,0 +1,123 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"net/http"
+	"strconv"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How long user has to enter second factor after password was checked.
+const loginChallengeValidity = 5 * time.Minute
+
+// Limits second factor attempts per user, otherwise 6-digit codes
+// might be brute forced.
+var twoFactorLimiter = helpers.NewRateLimiter(5, loginChallengeValidity)
+
+type TwoFactorLoginRequest struct {
+	Token string `form:"token"`
+	Code  string `form:"code"`
+}
+
+// Asks user which passed password check for second factor. Token
+// proves that password was checked and is sent back with code.
+func twoFactorChallenge(ec echo.Context, u *User) error {
+	token, err := issueToken(u, TokenLoginChallenge, loginChallengeValidity)
+	if err != nil {
+		log.Error().Msgf("Failed to issue login challenge for user '%s': %s", u.Login, err.Error())
+		return noticePage(ec, http.StatusInternalServerError, "Login failed", "Login failed, please try again later.")
+	}
+
+	return ec.HTML(http.StatusOK, twoFactorForm(ec, token, nil))
+}
+
+// Returns second factor form.
+func twoFactorForm(ec echo.Context, token string, errors []string) string {
+	return templater.GetTemplate(ec, "users/login_two_factor.html", map[string]string{
+		"errorsDiv": templater.GetErrorFlash(ec, errors),
+		"token":     token,
+	})
+}
+
+// Shows page for expired or invalid login challenge.
+func loginChallengeExpired(ec echo.Context) error {
+	return noticePage(ec, http.StatusBadRequest, "Login failed", "Login took too long, please <a href=\"/login/\">log in again</a>.")
+}
+
+func loginTwoFactorPOST(ec echo.Context) error {
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	req := &TwoFactorLoginRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	t, err := findToken(req.Token, TokenLoginChallenge)
+	if err != nil || time.Now().UTC().After(t.ExpiresAt) {
+		return loginChallengeExpired(ec)
+	}
+
+	u := GetUserByID(t.UserID)
+	if u == nil || !u.IsActive {
+		return loginChallengeExpired(ec)
+	}
+
+	if u.IsLocked() {
+		loginFailed(ec, u.Login, u, "account is locked")
+		return tooManyRequests(ec)
+	}
+
+	if !twoFactorLimiter.Allow(strconv.Itoa(u.ID)) {
+		loginFailed(ec, u.Login, u, "too many two-factor attempts")
+		return tooManyRequests(ec)
+	}
+
+	recovery, ok := u.CheckSecondFactor(req.Code)
+	if !ok {
+		loginFailed(ec, u.Login, u, "invalid two-factor code")
+		twoFactorAttemptFailed(ec, u)
+		return ec.HTML(http.StatusBadRequest, twoFactorForm(ec, req.Token, []string{"Invalid code."}))
+	}
+
+	if _, err1 := useToken(req.Token, TokenLoginChallenge); err1 != nil {
+		return loginChallengeExpired(ec)
+	}
+
+	if recovery {
+		audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionRecoveryCodeUsed, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, map[string]int{"recovery_codes_left": u.CountRecoveryCodes()})
+	}
+
+	return startSession(ec, u)
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	// local
+	"github.com/welltrainedfolks/magister/audit"
 	"github.com/welltrainedfolks/magister/internal/sessionkeys"
 	"github.com/welltrainedfolks/magister/internal/templater"
 
@@ -41,12 +42,15 @@ func logoutGET(ec echo.Context) error {
 	}
 	log.Debug().Msgf("Logging out user #%d", ec.Get("UID").(int))
 
-	// ToDo: check for error and do something.
 	c, err := ec.Cookie("s3ss1onk3y")
-	if c.Expires == time.Unix(0, 0) || err != nil {
+	if err != nil || c.Expires == time.Unix(0, 0) {
 		return ec.HTML(http.StatusOK, templater.GetTemplate(ec, "users/already_logged_out.html", map[string]string{}))
 	}
 
+	if u := GetCurrentlyLoggedInUser(ec); u != nil {
+		audit.Log(ec, &audit.Event{Action: audit.ActionLogout, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, nil)
+	}
+
 	c.Expires = time.Unix(0, 0)
 	ec.SetCookie(c)
 	sessionkeys.DeleteSessionKey(c.Value)
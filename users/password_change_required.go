@@ -0,0 +1,64 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"net/http"
+	"strings"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Where users which must change password are sent to.
+const passwordChangePath = "/profile/password/"
+
+// Paths which are available to users which must change password.
+var passwordChangeAllowedPaths = []string{passwordChangePath, "/logout/", "/static/"}
+
+// Redirects users which must change password (e.g. administrator forced
+// password reset) to password changing form.
+func passwordChangeEnforcer() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ec echo.Context) error {
+			authorized, _ := ec.Get("AUTHORIZED").(bool)
+			if !authorized {
+				return next(ec)
+			}
+
+			path := ec.Request().URL.Path
+			for _, allowed := range passwordChangeAllowedPaths {
+				if strings.HasPrefix(path, allowed) {
+					return next(ec)
+				}
+			}
+
+			u := GetCurrentlyLoggedInUser(ec)
+			if u != nil && u.MustChangePassword {
+				return ec.Redirect(http.StatusFound, passwordChangePath)
+			}
+
+			return next(ec)
+		}
+	}
+}
@@ -23,11 +23,13 @@ package users
 
 import (
 	// stdlib
+	"html"
 	"net/http"
 	//"strings"
 	//"time"
 
 	// local
+	"github.com/welltrainedfolks/magister/audit"
 	h "github.com/welltrainedfolks/magister/internal/http"
 	"github.com/welltrainedfolks/magister/internal/templater"
 
@@ -37,7 +39,6 @@ import (
 )
 
 type PasswordChangeRequest struct {
-	Login               string `form:"login"`
 	CurrentPassword     string `form:"current-password"`
 	NewPassword         string `form:"new-password"`
 	NewPasswordRepeated string `form:"new-repeated-password"`
@@ -48,6 +49,12 @@ func profileGET(ec echo.Context) error {
 		return ec.Redirect(http.StatusMovedPermanently, "/login_required/")
 	}
 
+	// Profile can't be managed with access token, otherwise token could
+	// create another one with wider scopes.
+	if CurrentAccessToken(ec) != nil {
+		return noticePage(ec, http.StatusForbidden, "Forbidden", "Profile can't be accessed with access token.")
+	}
+
 	// This data should be on every profile tab.
 	data := make(map[string]string)
 	data["csrf_token"] = ec.Get("CSRFTOKEN").(string)
@@ -62,6 +69,12 @@ func profileGET(ec echo.Context) error {
 		tabTpl = templater.GetRawTemplate(ec, "profile/general.html", data)
 	} else if tab == "password" {
 		tabTpl = templater.GetRawTemplate(ec, "profile/password.html", map[string]string{"errorsDiv": "", "successDiv": "", "csrf_token": ec.Get("CSRFTOKEN").(string)})
+	} else if tab == "2fa" {
+		tabTpl = twoFactorTab(ec, GetCurrentlyLoggedInUser(ec), nil, nil, nil)
+	} else if tab == "sessions" {
+		tabTpl = sessionsTab(ec, GetCurrentlyLoggedInUser(ec), nil, nil)
+	} else if tab == "tokens" {
+		tabTpl = tokensTab(ec, GetCurrentlyLoggedInUser(ec), nil, nil, "")
 	}
 
 	data["tab.data"] = tabTpl
@@ -69,6 +82,9 @@ func profileGET(ec echo.Context) error {
 	data["tab.general.active"] = ""
 	data["tab.contacts.active"] = ""
 	data["tab.password.active"] = ""
+	data["tab.2fa.active"] = ""
+	data["tab.sessions.active"] = ""
+	data["tab.tokens.active"] = ""
 	data["tab.forums-general.active"] = ""
 	// Set active.
 	data["tab."+tab+".active"] = "is-active"
@@ -83,11 +99,23 @@ func profilePOST(ec echo.Context) error {
 		return ec.Redirect(http.StatusMovedPermanently, "/login_required/")
 	}
 
+	// Profile can't be managed with access token, otherwise token could
+	// create another one with wider scopes.
+	if CurrentAccessToken(ec) != nil {
+		return noticePage(ec, http.StatusForbidden, "Forbidden", "Profile can't be accessed with access token.")
+	}
+
 	tab := ec.Param("tab")
 	log.Debug().Msgf("Profile POST on tab %s", tab)
 
 	if tab == "password" {
 		return profilePasswordPOST(ec)
+	} else if tab == "2fa" {
+		return profileTwoFactorPOST(ec)
+	} else if tab == "sessions" {
+		return profileSessionsPOST(ec)
+	} else if tab == "tokens" {
+		return profileTokensPOST(ec)
 	}
 
 	return h.NotFoundGET(ec)
@@ -102,12 +130,12 @@ func profilePasswordPOST(ec echo.Context) error {
 
 	var errors []string
 
-	u := GetUser(req.Login)
+	u := GetCurrentlyLoggedInUser(ec)
 	if u == nil {
 		errors = append(errors, "General system error, please try again later")
-	}
-
-	if !u.CheckPassword(req.CurrentPassword) {
+	} else if !u.IsLocal() {
+		errors = append(errors, "Your password is managed by "+u.AuthProvider+" and can't be changed here")
+	} else if !u.CheckPassword(req.CurrentPassword) {
 		errors = append(errors, "Invalid current password entered")
 	}
 
@@ -115,6 +143,12 @@ func profilePasswordPOST(ec echo.Context) error {
 		errors = append(errors, "New password and new password confirmation doesn't match")
 	}
 
+	if len(errors) == 0 {
+		if err := u.CreatePassword(req.NewPassword); err != nil {
+			errors = append(errors, "Failed to change password: "+html.EscapeString(err.Error()))
+		}
+	}
+
 	if len(errors) != 0 {
 		tabTpl := templater.GetRawTemplate(ec, "profile/password.html", map[string]string{"errorsDiv": templater.GetErrorFlash(ec, errors), "successDiv": "", "csrf_token": ec.Get("CSRFTOKEN").(string)})
 
@@ -128,8 +162,10 @@ func profilePasswordPOST(ec echo.Context) error {
 		return ec.HTML(http.StatusBadRequest, profileTpl)
 	}
 
-	u.CreatePassword(req.NewPassword)
+	u.MustChangePassword = false
 	u.Save()
+	rotateSessionKey(ec)
+	audit.Log(ec, &audit.Event{Action: audit.ActionPasswordChange, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, nil)
 
 	tabTpl := templater.GetRawTemplate(ec, "profile/password.html", map[string]string{"errorsDiv": templater.GetErrorFlash(ec, []string{}), "successDiv": templater.GetSuccessFlash(ec, []string{"Password successfully changed"}), "csrf_token": ec.Get("CSRFTOKEN").(string)})
 
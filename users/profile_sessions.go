@@ -0,0 +1,140 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+type SessionRequest struct {
+	Action string `form:"action"`
+	ID     int    `form:"id"`
+}
+
+// Returns sessions tab's HTML. Current session can't be revoked here,
+// logout does that.
+func sessionsTab(ec echo.Context, u *User, errors []string, successes []string) string {
+	var currentID int
+	if s := currentSession(ec); s != nil {
+		currentID = s.ID
+	}
+
+	var sessionsHTML string
+	for _, s := range sessionkeys.GetUserSessions(u.ID) {
+		userAgent := s.UserAgent
+		if userAgent == "" {
+			userAgent = "unknown"
+		}
+
+		ip := s.IP
+		if ip == "" {
+			ip = "unknown"
+		}
+
+		data := map[string]string{
+			"session.id":             strconv.Itoa(s.ID),
+			"session.user_agent":     html.EscapeString(userAgent),
+			"session.ip":             html.EscapeString(ip),
+			"session.created":        s.CreatedAt.Format("2006-01-02 15:04:05"),
+			"session.last_seen":      s.LastSeenAt.Format("2006-01-02 15:04:05"),
+			"session.current.hidden": "is-hidden",
+			"session.revoke.hidden":  "",
+		}
+
+		if s.ID == currentID {
+			data["session.current.hidden"] = ""
+			data["session.revoke.hidden"] = "is-hidden"
+		}
+
+		sessionsHTML += templater.GetRawTemplate(ec, "profile/sessions_item.html", data)
+	}
+
+	return templater.GetRawTemplate(ec, "profile/sessions.html", map[string]string{
+		"errorsDiv":     templater.GetErrorFlash(ec, errors),
+		"successDiv":    templater.GetSuccessFlash(ec, successes),
+		"sessions":      sessionsHTML,
+		"idle_hours":    strconv.Itoa(settings.Int("http.session_idle_hours")),
+		"validity_days": strconv.Itoa(settings.Int("http.session_validity_days")),
+	})
+}
+
+func profileSessionsPOST(ec echo.Context) error {
+	req := &SessionRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	u := GetCurrentlyLoggedInUser(ec)
+	s := currentSession(ec)
+	if u == nil || s == nil {
+		return noticePage(ec, http.StatusInternalServerError, "Error", "General system error, please try again later.")
+	}
+
+	var errors []string
+	var successes []string
+
+	switch req.Action {
+	case "revoke":
+		if req.ID == s.ID {
+			errors = append(errors, "Use logout to end current session.")
+		} else if err := sessionkeys.DeleteUserSession(u.ID, req.ID); err != nil {
+			errors = append(errors, "Session wasn't found.")
+		} else {
+			successes = append(successes, "Device was logged out.")
+			audit.Log(ec, &audit.Event{Action: audit.ActionSessionRevoke, TargetType: audit.TargetSession, TargetID: req.ID, Target: u.Login}, nil, nil)
+		}
+	case "revoke_others":
+		if err := sessionkeys.DeleteOtherUserSessions(u.ID, s.ID); err != nil {
+			errors = append(errors, "Failed to log out other devices: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "All other devices were logged out.")
+			audit.Log(ec, &audit.Event{Action: audit.ActionSessionRevokeOthers, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, nil)
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	formData := map[string]string{
+		"tab.data":            sessionsTab(ec, u, errors, successes),
+		"tab.sessions.active": "is-active",
+	}
+
+	return ec.HTML(status, templater.GetTemplate(ec, "profile/skeleton.html", formData))
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+type AccessTokenRequest struct {
+	Action    string   `form:"action"`
+	ID        int      `form:"id"`
+	Name      string   `form:"name"`
+	Scopes    []string `form:"scopes"`
+	ExpiresIn int      `form:"expires_in"`
+}
+
+// Returns access tokens tab's HTML. Token's value is shown only right
+// after it was created.
+func tokensTab(ec echo.Context, u *User, errors []string, successes []string, newToken string) string {
+	var tokensHTML string
+	for _, t := range u.GetAccessTokens() {
+		expires := "never"
+		if t.ExpiresAt != nil {
+			expires = t.ExpiresAt.Format("2006-01-02")
+			if t.IsExpired() {
+				expires += " (expired)"
+			}
+		}
+
+		lastUsed := "never"
+		if t.LastUsedAt != nil {
+			lastUsed = t.LastUsedAt.Format("2006-01-02 15:04:05")
+		}
+
+		tokensHTML += templater.GetRawTemplate(ec, "profile/tokens_item.html", map[string]string{
+			"token.id":        strconv.Itoa(t.ID),
+			"token.name":      html.EscapeString(t.Name),
+			"token.scopes":    strings.Join(t.ScopesList(), ", "),
+			"token.created":   t.CreatedAt.Format("2006-01-02 15:04:05"),
+			"token.expires":   expires,
+			"token.last_used": lastUsed,
+		})
+	}
+
+	data := map[string]string{
+		"errorsDiv":  templater.GetErrorFlash(ec, errors),
+		"successDiv": templater.GetSuccessFlash(ec, successes),
+		"tokens":     tokensHTML,
+		"newToken":   "",
+	}
+
+	if newToken != "" {
+		data["newToken"] = templater.GetRawTemplate(ec, "profile/tokens_new.html", map[string]string{"token": newToken})
+	}
+
+	return templater.GetRawTemplate(ec, "profile/tokens.html", data)
+}
+
+func profileTokensPOST(ec echo.Context) error {
+	req := &AccessTokenRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	u := GetCurrentlyLoggedInUser(ec)
+	if u == nil {
+		return noticePage(ec, http.StatusInternalServerError, "Error", "General system error, please try again later.")
+	}
+
+	var errors []string
+	var successes []string
+	var newToken string
+
+	switch req.Action {
+	case "create":
+		if req.ExpiresIn < 0 {
+			errors = append(errors, "Invalid expiration period.")
+			break
+		}
+
+		t, token, err := u.CreateAccessToken(req.Name, req.Scopes, time.Duration(req.ExpiresIn)*24*time.Hour)
+		if err != nil {
+			errors = append(errors, "Failed to create token: "+html.EscapeString(err.Error()))
+			break
+		}
+
+		newToken = token
+		successes = append(successes, "Token created.")
+		audit.Log(ec, &audit.Event{Action: audit.ActionTokenCreate, TargetType: audit.TargetToken, TargetID: t.ID, Target: t.Name}, nil, t.AuditData())
+	case "revoke":
+		t := u.GetAccessToken(req.ID)
+		if t == nil {
+			errors = append(errors, "Token wasn't found.")
+		} else if err := t.Revoke(); err != nil {
+			errors = append(errors, "Failed to revoke token: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Token revoked.")
+			audit.Log(ec, &audit.Event{Action: audit.ActionTokenRevoke, TargetType: audit.TargetToken, TargetID: t.ID, Target: t.Name}, t.AuditData(), nil)
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	formData := map[string]string{
+		"tab.data":          tokensTab(ec, u, errors, successes, newToken),
+		"tab.tokens.active": "is-active",
+	}
+
+	return ec.HTML(status, templater.GetTemplate(ec, "profile/skeleton.html", formData))
+}
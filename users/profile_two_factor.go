@@ -0,0 +1,171 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+type TwoFactorProfileRequest struct {
+	Action string `form:"action"`
+	Code   string `form:"code"`
+}
+
+// Returns two-factor authentication tab's HTML. Recovery codes are
+// shown only right after they were generated.
+func twoFactorTab(ec echo.Context, u *User, errors []string, successes []string, codes []string) string {
+	data := map[string]string{
+		"errorsDiv":     templater.GetErrorFlash(ec, errors),
+		"successDiv":    templater.GetSuccessFlash(ec, successes),
+		"csrf_token":    ec.Get("CSRFTOKEN").(string),
+		"recoveryCodes": "",
+		"required":      "",
+	}
+
+	if len(codes) != 0 {
+		data["recoveryCodes"] = templater.GetRawTemplate(ec, "profile/two_factor_recovery_codes.html", map[string]string{"codes": strings.Join(codes, "\n")})
+	}
+
+	if u.IsTwoFactorRequired() && !u.TOTPEnabled {
+		data["required"] = templater.GetRawTemplate(ec, "profile/two_factor_required.html", nil)
+	}
+
+	if u.TOTPEnabled {
+		data["recovery_codes_left"] = strconv.Itoa(u.CountRecoveryCodes())
+		data["disable.hidden"] = ""
+		if u.IsTwoFactorRequired() {
+			data["disable.hidden"] = "is-hidden"
+		}
+
+		return templater.GetRawTemplate(ec, "profile/two_factor_enabled.html", data)
+	}
+
+	if u.TOTPSecret != "" {
+		qr, err := u.TwoFactorQR()
+		if err != nil {
+			log.Error().Msgf("Failed to render QR code for user '%s': %s", u.Login, err.Error())
+		}
+
+		data["qr"] = qr
+		data["secret"] = html.EscapeString(u.TOTPSecret)
+		return templater.GetRawTemplate(ec, "profile/two_factor_enrolment.html", data)
+	}
+
+	return templater.GetRawTemplate(ec, "profile/two_factor_disabled.html", data)
+}
+
+func profileTwoFactorPOST(ec echo.Context) error {
+	req := &TwoFactorProfileRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	u := GetCurrentlyLoggedInUser(ec)
+	if u == nil {
+		return noticePage(ec, http.StatusInternalServerError, "Error", "General system error, please try again later.")
+	}
+
+	var errors []string
+	var successes []string
+	var codes []string
+	event := &audit.Event{TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}
+
+	switch req.Action {
+	case "start":
+		if err := u.StartTwoFactorEnrolment(); err != nil {
+			errors = append(errors, "Failed to start setup: "+html.EscapeString(err.Error()))
+		}
+	case "enable":
+		recoveryCodes, err := u.EnableTwoFactor(req.Code)
+		if err != nil {
+			errors = append(errors, "Failed to enable two-factor authentication: "+html.EscapeString(err.Error()))
+		} else {
+			codes = recoveryCodes
+			successes = append(successes, "Two-factor authentication enabled.")
+			rotateSessionKey(ec)
+			event.Action = audit.ActionTwoFactorEnable
+			audit.Log(ec, event, nil, nil)
+		}
+	case "regenerate":
+		if !u.TOTPEnabled || !u.checkTOTP(req.Code) {
+			errors = append(errors, "Invalid code.")
+			break
+		}
+
+		recoveryCodes, err := u.GenerateRecoveryCodes()
+		if err != nil {
+			errors = append(errors, "Failed to generate recovery codes: "+html.EscapeString(err.Error()))
+		} else {
+			codes = recoveryCodes
+			successes = append(successes, "New recovery codes generated.")
+			event.Action = audit.ActionRecoveryCodesRegenerate
+			audit.Log(ec, event, nil, nil)
+		}
+	case "disable":
+		if u.IsTwoFactorRequired() {
+			errors = append(errors, "Your role requires two-factor authentication, it can't be disabled.")
+			break
+		}
+
+		if !u.TOTPEnabled || !u.checkTOTP(req.Code) {
+			errors = append(errors, "Invalid code.")
+			break
+		}
+
+		if err := u.DisableTwoFactor(); err != nil {
+			errors = append(errors, "Failed to disable two-factor authentication: "+html.EscapeString(err.Error()))
+		} else {
+			successes = append(successes, "Two-factor authentication disabled.")
+			rotateSessionKey(ec)
+			event.Action = audit.ActionTwoFactorDisable
+			audit.Log(ec, event, nil, nil)
+		}
+	default:
+		errors = append(errors, "Unknown action.")
+	}
+
+	status := http.StatusOK
+	if len(errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	formData := map[string]string{
+		"tab.data":            twoFactorTab(ec, u, errors, successes, codes),
+		"tab.general.active":  "",
+		"tab.password.active": "",
+		"tab.2fa.active":      "is-active",
+	}
+
+	return ec.HTML(status, templater.GetTemplate(ec, "profile/skeleton.html", formData))
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/passwords"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Authentication providers.
+const (
+	// Password is stored in MAGISTER's database.
+	ProviderLocal = "local"
+	// Password is checked by LDAP or Active Directory server.
+	ProviderLDAP = "ldap"
+	// User logs in with OpenID Connect identity provider.
+	ProviderOIDC = "oidc"
+)
+
+var (
+	// Login or password is wrong.
+	errInvalidCredentials = errors.New("invalid login or password")
+	// Credentials are valid, but user isn't allowed to use MAGISTER.
+	errNotAllowed = errors.New("user isn't allowed to log in")
+)
+
+// Identity is what provider knows about authenticated user. Issuer and
+// subject are set only by OpenID Connect provider.
+type Identity struct {
+	Login   string
+	Email   string
+	Role    string
+	Issuer  string
+	Subject string
+}
+
+// AuthProvider checks user's login and password.
+type AuthProvider interface {
+	// Name is stored with users which this provider authenticates.
+	Name() string
+	// Enabled returns true if provider might be used.
+	Enabled() bool
+	// Authenticate checks credentials. User is nil if there is no such
+	// user in database yet, provider which returns identity for such
+	// user lets it be created. Returns errInvalidCredentials if
+	// credentials are wrong, errNotAllowed if user shouldn't log in
+	// and any other error if provider failed to check them.
+	Authenticate(u *User, login string, password string) (*Identity, error)
+}
+
+// Providers in order they're asked about unknown users.
+var providers = []AuthProvider{&localProvider{}, &ldapProvider{}, &oidcProvider{}}
+
+// Returns provider with passed name.
+func getProvider(name string) AuthProvider {
+	for _, p := range providers {
+		if p.Name() == name {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// IsValidProvider returns true if passed authentication provider
+// exists.
+func IsValidProvider(name string) bool {
+	return getProvider(name) != nil
+}
+
+// IsLocal returns true if user's password is stored in MAGISTER's
+// database and might be changed here.
+func (u *User) IsLocal() bool {
+	return u.AuthProvider == ProviderLocal
+}
+
+// Checks credentials with provider of passed user. Unknown users are
+// checked with every enabled provider and created after first success.
+// Returns user which was authenticated.
+func authenticate(ec echo.Context, u *User, login string, password string) (*User, error) {
+	if u != nil {
+		p := getProvider(u.AuthProvider)
+		if p == nil || !p.Enabled() {
+			log.Warn().Msgf("Authentication provider '%s' of user '%s' is disabled", u.AuthProvider, u.Login)
+			return u, errInvalidCredentials
+		}
+
+		identity, err := p.Authenticate(u, login, password)
+		if err != nil {
+			return u, err
+		}
+
+		syncUser(ec, u, identity)
+		return u, nil
+	}
+
+	authErr := errInvalidCredentials
+	for _, p := range providers {
+		if !p.Enabled() {
+			continue
+		}
+
+		identity, err := p.Authenticate(nil, login, password)
+		if err == errInvalidCredentials {
+			continue
+		}
+
+		if err != nil {
+			log.Error().Msgf("Provider '%s' failed to authenticate '%s': %s", p.Name(), login, err.Error())
+			authErr = err
+			continue
+		}
+
+		return provisionUser(ec, p, identity)
+	}
+
+	return nil, authErr
+}
+
+// Creates user which was authenticated by external provider for the
+// first time. Such users are active right away.
+func provisionUser(ec echo.Context, p AuthProvider, identity *Identity) (*User, error) {
+	u := &User{
+		Login:        identity.Login,
+		Email:        identity.Email,
+		Role:         identity.Role,
+		AuthProvider: p.Name(),
+		OIDCIssuer:   identity.Issuer,
+		OIDCSubject:  identity.Subject,
+		IsActive:     true,
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+
+	if err := u.Validate(); err != nil {
+		return nil, errors.New("failed to create user '" + u.Login + "': " + err.Error())
+	}
+
+	if err := u.Create(); err != nil {
+		return nil, err
+	}
+
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionUserProvision, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, u.AuditData())
+	return u, nil
+}
+
+// Updates user's email and role with ones from provider. Last active
+// admin keeps it's role, otherwise nobody would be able to manage
+// MAGISTER after groups were changed at provider.
+func syncUser(ec echo.Context, u *User, identity *Identity) {
+	role := identity.Role
+	if u.Role == RoleAdmin && role != RoleAdmin && u.IsActive && CountActiveAdmins() <= 1 {
+		log.Warn().Msgf("'%s' gives role '%s' to user '%s', but it's the last active admin, role isn't changed", u.AuthProvider, role, u.Login)
+		role = RoleAdmin
+	}
+
+	if u.Email == identity.Email && u.Role == role {
+		return
+	}
+
+	before := u.AuditData()
+	u.Email = identity.Email
+	u.Role = role
+	if err := u.Validate(); err != nil {
+		log.Error().Msgf("Failed to update user '%s' with data from '%s': %s", u.Login, u.AuthProvider, err.Error())
+		return
+	}
+
+	u.Save()
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionUserSync, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, before, u.AuditData())
+}
+
+// Returns most powerful role which passed mapping ("group:role" pairs)
+// gives to passed groups, or empty string if none of groups is mapped.
+// Groups are compared case-insensitively.
+func mapRole(mapping []string, groups []string) string {
+	mapped := make(map[string]bool)
+	for _, item := range mapping {
+		idx := strings.LastIndex(item, ":")
+		if idx < 1 {
+			continue
+		}
+
+		for _, group := range groups {
+			if strings.EqualFold(group, item[:idx]) {
+				mapped[item[idx+1:]] = true
+			}
+		}
+	}
+
+	for _, role := range Roles {
+		if mapped[role] {
+			return role
+		}
+	}
+
+	return ""
+}
+
+// Hashes unknown logins are checked against, by algorithm. They're made
+// on first use.
+var (
+	dummyHashes      = make(map[string]string)
+	dummyHashesMutex sync.Mutex
+)
+
+// Checks password against dummy hash made with configured algorithm, as
+// if it was checked for existing user.
+func checkDummyPassword(password string) {
+	algorithm := settings.String("security.password_hash")
+
+	dummyHashesMutex.Lock()
+	hash, found := dummyHashes[algorithm]
+	if !found {
+		var err error
+		if hash, err = passwords.Hash("dummy password", algorithm); err != nil {
+			dummyHashesMutex.Unlock()
+			log.Error().Msgf("Failed to make dummy password hash: %s", err.Error())
+			return
+		}
+		dummyHashes[algorithm] = hash
+	}
+	dummyHashesMutex.Unlock()
+
+	passwords.Verify(password, hash)
+}
+
+// Checks passwords stored in MAGISTER's database. Local users exist
+// regardless of other providers, so they might be used when e.g. LDAP
+// server is unavailable.
+type localProvider struct{}
+
+func (p *localProvider) Name() string {
+	return ProviderLocal
+}
+
+func (p *localProvider) Enabled() bool {
+	return true
+}
+
+func (p *localProvider) Authenticate(u *User, login string, password string) (*Identity, error) {
+	if u == nil {
+		// Unknown login takes as long as wrong password, so response
+		// time doesn't tell which logins exist.
+		checkDummyPassword(password)
+		return nil, errInvalidCredentials
+	}
+
+	if !u.CheckPassword(password) {
+		return nil, errInvalidCredentials
+	}
+
+	u.rehashPassword(password)
+
+	return &Identity{Login: u.Login, Email: u.Email, Role: u.Role}, nil
+}
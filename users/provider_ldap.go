@@ -0,0 +1,225 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"crypto/tls"
+	"errors"
+	"net"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"gopkg.in/ldap.v3"
+)
+
+// Timeout for LDAP connection and requests, login form shouldn't hang
+// if server is unavailable.
+const ldapTimeout = 10 * time.Second
+
+// Checks passwords with LDAP or Active Directory server. User is found
+// with search made as bind DN, then password is checked by binding as
+// found user. Role is decided by user's groups on every login.
+type ldapProvider struct{}
+
+func (p *ldapProvider) Name() string {
+	return ProviderLDAP
+}
+
+func (p *ldapProvider) Enabled() bool {
+	return settings.Bool("ldap.enabled")
+}
+
+func (p *ldapProvider) Authenticate(u *User, login string, password string) (*Identity, error) {
+	// Bind with empty password is an unauthenticated bind, which
+	// succeeds for any DN.
+	if password == "" {
+		return nil, errInvalidCredentials
+	}
+
+	// Existing users might log in with email, but LDAP is searched by
+	// login.
+	if u != nil {
+		login = u.Login
+	}
+
+	conn, err := ldapConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entry, err1 := ldapFindUser(conn, login)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	if entry == nil {
+		return nil, errInvalidCredentials
+	}
+
+	if err2 := conn.Bind(entry.DN, password); err2 != nil {
+		if ldap.IsErrorWithCode(err2, ldap.LDAPResultInvalidCredentials) {
+			return nil, errInvalidCredentials
+		}
+
+		return nil, err2
+	}
+
+	role := ldapRole(entry.GetAttributeValues(settings.String("ldap.group_attribute")))
+	if role == "" {
+		return nil, errNotAllowed
+	}
+
+	identity := &Identity{
+		Login: entry.GetAttributeValue(settings.String("ldap.login_attribute")),
+		Email: entry.GetAttributeValue(settings.String("ldap.email_attribute")),
+		Role:  role,
+	}
+
+	if identity.Login == "" {
+		identity.Login = login
+	}
+
+	return identity, nil
+}
+
+// Connects to LDAP server and binds as bind DN.
+func ldapConnect() (*ldap.Conn, error) {
+	url, err := neturl.Parse(settings.String("ldap.url"))
+	if err != nil {
+		return nil, err
+	}
+
+	host := url.Hostname()
+	port := url.Port()
+	if port == "" {
+		port = ldap.DefaultLdapPort
+		if url.Scheme == "ldaps" {
+			port = ldap.DefaultLdapsPort
+		}
+	}
+
+	dialer := &net.Dialer{Timeout: ldapTimeout}
+	tlsConfig := &tls.Config{ServerName: host}
+
+	var c net.Conn
+	switch url.Scheme {
+	case "ldap":
+		c, err = dialer.Dial("tcp", net.JoinHostPort(host, port))
+	case "ldaps":
+		c, err = tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, port), tlsConfig)
+	default:
+		return nil, errors.New("unknown LDAP URL scheme '" + url.Scheme + "'")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	conn := ldap.NewConn(c, url.Scheme == "ldaps")
+	conn.SetTimeout(ldapTimeout)
+	conn.Start()
+
+	if url.Scheme == "ldap" && settings.Bool("ldap.start_tls") {
+		if err1 := conn.StartTLS(tlsConfig); err1 != nil {
+			conn.Close()
+			return nil, err1
+		}
+	}
+
+	if bindDN := settings.String("ldap.bind_dn"); bindDN != "" {
+		if err1 := conn.Bind(bindDN, settings.String("ldap.bind_password")); err1 != nil {
+			conn.Close()
+			return nil, errors.New("failed to bind as '" + bindDN + "': " + err1.Error())
+		}
+	}
+
+	return conn, nil
+}
+
+// Searches user with passed login. Returns nil if there is no such
+// user, or if login isn't unique.
+func ldapFindUser(conn *ldap.Conn, login string) (*ldap.Entry, error) {
+	filter := strings.Replace(settings.String("ldap.user_filter"), "%s", ldap.EscapeFilter(login), -1)
+	req := ldap.NewSearchRequest(
+		settings.String("ldap.base_dn"),
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout/time.Second), false,
+		filter,
+		[]string{settings.String("ldap.login_attribute"), settings.String("ldap.email_attribute"), settings.String("ldap.group_attribute")},
+		nil,
+	)
+
+	res, err := conn.Search(req)
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, err
+	}
+
+	if res == nil || len(res.Entries) != 1 {
+		return nil, nil
+	}
+
+	return res.Entries[0], nil
+}
+
+// Returns role for user with passed groups. Groups might be DNs (as in
+// memberOf) or plain names, mapping uses names.
+func ldapRole(groups []string) string {
+	names := make([]string, 0, len(groups))
+	for _, g := range groups {
+		names = append(names, ldapGroupName(g))
+	}
+
+	if role := mapRole(settings.List("ldap.role_mapping"), names); role != "" {
+		return role
+	}
+
+	return settings.String("ldap.default_role")
+}
+
+// Returns group's name, e.g. "developers" for
+// "cn=developers,ou=groups,dc=example,dc=com".
+func ldapGroupName(group string) string {
+	dn, err := ldap.ParseDN(group)
+	if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+		return group
+	}
+
+	return dn.RDNs[0].Attributes[0].Value
+}
+
+// CheckLDAP checks passed credentials against LDAP server even if LDAP
+// authentication is disabled, without creating or updating user. Used
+// to check LDAP configuration.
+func CheckLDAP(login string, password string) (*Identity, error) {
+	identity, err := (&ldapProvider{}).Authenticate(nil, login, password)
+	if err == errNotAllowed {
+		return nil, errors.New("user isn't in any mapped group and default role is empty")
+	}
+
+	return identity, err
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"errors"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/oidc"
+	"github.com/welltrainedfolks/magister/internal/settings"
+)
+
+// Users of OpenID Connect identity provider log in with "Sign in with"
+// button, provider never checks passwords. Role is decided by user's
+// groups on every login.
+type oidcProvider struct{}
+
+func (p *oidcProvider) Name() string {
+	return ProviderOIDC
+}
+
+func (p *oidcProvider) Enabled() bool {
+	return settings.Bool("oidc.enabled") && settings.String("oidc.issuer") != "" && settings.String("oidc.client_id") != ""
+}
+
+func (p *oidcProvider) Authenticate(u *User, login string, password string) (*Identity, error) {
+	return nil, errInvalidCredentials
+}
+
+// Returns identity from ID token's claims.
+func oidcIdentity(claims oidc.Claims) (*Identity, error) {
+	identity := &Identity{
+		Login:   claims.String(settings.String("oidc.login_claim")),
+		Email:   claims.String(settings.String("oidc.email_claim")),
+		Issuer:  claims.String("iss"),
+		Subject: claims.String("sub"),
+	}
+
+	if identity.Issuer == "" || identity.Subject == "" {
+		return nil, errors.New("ID token has no 'iss' or 'sub' claim")
+	}
+
+	if identity.Login == "" {
+		return nil, errors.New("ID token has no '" + settings.String("oidc.login_claim") + "' claim")
+	}
+
+	// Email of created user should really belong to it.
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, errors.New("email of '" + identity.Login + "' isn't verified by identity provider")
+	}
+
+	identity.Role = mapRole(settings.List("oidc.role_mapping"), claims.Strings(settings.String("oidc.groups_claim")))
+	if identity.Role == "" {
+		identity.Role = settings.String("oidc.default_role")
+	}
+
+	if identity.Role == "" {
+		return nil, errNotAllowed
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"sync"
+	"time"
+)
+
+// Slows down repeated failures per key: after free failures every next
+// one doubles delay before next attempt is allowed. Failures are
+// forgotten if there were none for a while. State is kept in memory.
+type backoff struct {
+	free   int
+	base   time.Duration
+	max    time.Duration
+	forget time.Duration
+
+	failures      map[string]*backoffState
+	failuresMutex sync.Mutex
+}
+
+type backoffState struct {
+	count int
+	last  time.Time
+}
+
+func newBackoff(free int, base time.Duration, max time.Duration, forget time.Duration) *backoff {
+	return &backoff{
+		free:     free,
+		base:     base,
+		max:      max,
+		forget:   forget,
+		failures: make(map[string]*backoffState),
+	}
+}
+
+// Reserve records attempt for passed key, which counts as failure until
+// it's released, and returns zero. If key has to wait after previous
+// failures, nothing is recorded and remaining delay is returned. Check
+// and record are atomic, so concurrent attempts can't all pass the
+// check.
+func (b *backoff) Reserve(key string) time.Duration {
+	b.failuresMutex.Lock()
+	defer b.failuresMutex.Unlock()
+
+	now := time.Now()
+	for k, state := range b.failures {
+		if now.Sub(state.last) > b.forget {
+			delete(b.failures, k)
+		}
+	}
+
+	state, found := b.failures[key]
+	if !found {
+		state = &backoffState{}
+		b.failures[key] = state
+	}
+
+	if wait := backoffDelay(state.count, b.free, b.base, b.max) - now.Sub(state.last); wait > 0 {
+		return wait
+	}
+
+	state.count++
+	state.last = now
+
+	return 0
+}
+
+// Release forgets attempt reserved for passed key, as it succeeded.
+func (b *backoff) Release(key string) {
+	b.failuresMutex.Lock()
+	defer b.failuresMutex.Unlock()
+
+	state, found := b.failures[key]
+	if !found {
+		return
+	}
+
+	state.count--
+	if state.count <= 0 {
+		delete(b.failures, key)
+	}
+}
+
+// Returns delay after passed count of failures: zero for free ones,
+// then base delay doubled on every failure, but not more than max.
+func backoffDelay(failures int, free int, base time.Duration, max time.Duration) time.Duration {
+	if failures <= free {
+		return 0
+	}
+
+	// Shifting further would overflow, and max is reached anyway.
+	exponent := failures - free - 1
+	if exponent > 30 {
+		return max
+	}
+
+	delay := base << uint(exponent)
+	if delay > max || delay <= 0 {
+		return max
+	}
+
+	return delay
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/config"
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/mailsender"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// What self-registered user waits for before being able to log in.
+const (
+	RegistrationAwaitingEmail    = "email"
+	RegistrationAwaitingApproval = "approval"
+)
+
+// How often activation mail might be resent.
+const activationResendInterval = time.Minute
+
+type RegistrationRequest struct {
+	Login            string `form:"login"`
+	Email            string `form:"email"`
+	Password         string `form:"password"`
+	PasswordRepeated string `form:"password-repeat"`
+}
+
+type ResendActivationRequest struct {
+	Email string `form:"email"`
+}
+
+// Returns true if email's domain is allowed to register.
+func isEmailDomainAllowed(email string) bool {
+	domains := settings.List("registration.allowed_domains")
+	if len(domains) == 0 {
+		return true
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+	for _, allowed := range domains {
+		if strings.EqualFold(domain, strings.TrimPrefix(allowed, "@")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns absolute URL for passed path and query.
+func absoluteURL(path string, query url.Values) string {
+	link := strings.TrimSuffix(config.Config.HTTP.Domain, "/") + path
+	if len(query) != 0 {
+		link += "?" + query.Encode()
+	}
+
+	return link
+}
+
+// Issues activation token and sends it to user.
+func sendActivationMail(u *User) error {
+	validity := settings.Int("registration.token_validity_hours")
+	token, err := issueToken(u, TokenActivation, time.Duration(validity)*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	mailsender.SendMail("mail/activation.txt", map[string]string{
+		"mail.to":          u.Email,
+		"mail.subject":     "Activate your account",
+		"user.login":       u.Login,
+		"activation.link":  absoluteURL("/activate/", url.Values{"token": {token}}),
+		"activation.hours": strconv.Itoa(validity),
+	})
+
+	return nil
+}
+
+// Tells active admins that user waits for their approval.
+func notifyAdminsAboutRegistration(u *User) {
+	for _, admin := range GetUsers() {
+		if !admin.Can(PermUsersManage) {
+			continue
+		}
+
+		mailsender.SendMail("mail/registration_approval.txt", map[string]string{
+			"mail.to":      admin.Email,
+			"mail.subject": "User " + u.Login + " awaits approval",
+			"user.login":   u.Login,
+			"user.email":   u.Email,
+			"user.link":    absoluteURL("/admin/users/", url.Values{"id": {strconv.Itoa(u.ID)}}),
+		})
+	}
+}
+
+// NotifyApproved tells user that administrator has approved it's
+// registration.
+func NotifyApproved(u *User) {
+	mailsender.SendMail("mail/registration_approved.txt", map[string]string{
+		"mail.to":      u.Email,
+		"mail.subject": "Your account is activated",
+		"user.login":   u.Login,
+		"login.link":   absoluteURL("/login/", nil),
+	})
+}
+
+// Shows page with single message.
+func noticePage(ec echo.Context, status int, title string, message string) error {
+	return ec.HTML(status, templater.GetTemplate(ec, "users/notice.html", map[string]string{
+		"notice.title":   title,
+		"notice.message": message,
+	}))
+}
+
+func registerGET(ec echo.Context) error {
+	if !settings.Bool("registration.enabled") {
+		return h.NotFoundGET(ec)
+	}
+
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	return ec.HTML(http.StatusOK, registrationForm(ec, &RegistrationRequest{}, nil))
+}
+
+// Returns registration form filled with passed data.
+func registrationForm(ec echo.Context, req *RegistrationRequest, errors []string) string {
+	var domains string
+	if allowed := settings.List("registration.allowed_domains"); len(allowed) != 0 {
+		domains = "Only emails from these domains are allowed: " + html.EscapeString(strings.Join(allowed, ", ")) + "."
+	}
+
+	return templater.GetTemplate(ec, "users/register.html", map[string]string{
+		"errorsDiv": templater.GetErrorFlash(ec, errors),
+		"login":     html.EscapeString(req.Login),
+		"email":     html.EscapeString(req.Email),
+		"domains":   domains,
+	})
+}
+
+func registerPOST(ec echo.Context) error {
+	if !settings.Bool("registration.enabled") {
+		return h.NotFoundGET(ec)
+	}
+
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	req := &RegistrationRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	u := &User{
+		Login:     strings.TrimSpace(req.Login),
+		Email:     strings.TrimSpace(req.Email),
+		Role:      DefaultRole,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var errors []string
+	if req.Password == "" {
+		errors = append(errors, "Password should not be empty.")
+	} else if req.Password != req.PasswordRepeated {
+		errors = append(errors, "Passwords doesn't match.")
+	}
+
+	if err := u.Validate(); err != nil {
+		errors = append(errors, "Invalid registration data: "+html.EscapeString(err.Error())+".")
+	} else if !isEmailDomainAllowed(u.Email) {
+		errors = append(errors, "Registration with this email isn't allowed.")
+	}
+
+	if len(errors) != 0 {
+		return ec.HTML(http.StatusBadRequest, registrationForm(ec, req, errors))
+	}
+
+	u.RegistrationState = RegistrationAwaitingEmail
+	if settings.String("registration.verification") == "approval" {
+		u.RegistrationState = RegistrationAwaitingApproval
+	}
+
+	if err := u.CreatePassword(req.Password); err != nil {
+		return ec.HTML(http.StatusBadRequest, registrationForm(ec, req, []string{"Invalid password: " + html.EscapeString(err.Error()) + "."}))
+	}
+
+	if err := u.Create(); err != nil {
+		log.Error().Msgf("Failed to register user '%s': %s", u.Login, err.Error())
+		return ec.HTML(http.StatusInternalServerError, registrationForm(ec, req, []string{"Registration failed, please try again later."}))
+	}
+
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionUserRegister, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, u.AuditData())
+
+	if u.RegistrationState == RegistrationAwaitingApproval {
+		notifyAdminsAboutRegistration(u)
+		return noticePage(ec, http.StatusOK, "Registration completed", "Your account will be available after administrator's approval.")
+	}
+
+	if err := sendActivationMail(u); err != nil {
+		log.Error().Msgf("Failed to issue activation token for user '%s': %s", u.Login, err.Error())
+	}
+
+	return noticePage(ec, http.StatusOK, "Registration completed", "Mail with activation link was sent to <b>"+html.EscapeString(u.Email)+"</b>. If it doesn't arrive - <a href=\"/resend_activation/\">request new one</a>.")
+}
+
+func activateGET(ec echo.Context) error {
+	u, err := useToken(ec.QueryParam("token"), TokenActivation)
+	if err != nil {
+		log.Warn().Msgf("Activation failed: %s", err.Error())
+		return noticePage(ec, http.StatusBadRequest, "Activation failed", "Activation link is invalid, was already used or has expired. <a href=\"/resend_activation/\">Request new one</a>.")
+	}
+
+	if u.RegistrationState != RegistrationAwaitingEmail {
+		return noticePage(ec, http.StatusBadRequest, "Activation failed", "This account doesn't need activation.")
+	}
+
+	before := u.AuditData()
+	u.SetActive()
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionUserActivate, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, before, u.AuditData())
+
+	return noticePage(ec, http.StatusOK, "Account activated", "Your account is activated, now you can <a href=\"/login/\">log in</a>.")
+}
+
+func resendActivationGET(ec echo.Context) error {
+	return ec.HTML(http.StatusOK, templater.GetTemplate(ec, "users/resend_activation.html", map[string]string{"email": ""}))
+}
+
+// Sends new activation link. Response is same whether such user exists
+// or not, so this form can't be used to find out registered emails.
+func resendActivationPOST(ec echo.Context) error {
+	req := &ResendActivationRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return ec.HTML(http.StatusBadRequest, templater.GetTemplate(ec, "users/resend_activation.html", map[string]string{"email": ""}))
+	}
+
+	if !allowMailRequest(ec) {
+		log.Warn().Msgf("Activation link resending for '%s' was rate limited", email)
+		return tooManyRequests(ec)
+	}
+
+	u := GetUser(email)
+	if u != nil && u.RegistrationState == RegistrationAwaitingEmail && time.Since(lastTokenIssuedAt(u.ID, TokenActivation)) > activationResendInterval {
+		if err := sendActivationMail(u); err != nil {
+			log.Error().Msgf("Failed to issue activation token for user '%s': %s", u.Login, err.Error())
+		}
+	}
+
+	return noticePage(ec, http.StatusOK, "Activation link sent", "If account with email <b>"+html.EscapeString(email)+"</b> waits for activation - new activation link was sent to it.")
+}
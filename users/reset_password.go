@@ -0,0 +1,203 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/audit"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/mailsender"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/templater"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// How often password reset mail might be sent to same user.
+const passwordResetInterval = 5 * time.Minute
+
+// Limits requests which send mails (password reset, activation link
+// resending) from single IP address.
+var mailRequestsLimiter = helpers.NewRateLimiter(5, time.Hour)
+
+type PasswordResetRequest struct {
+	Email string `form:"email"`
+}
+
+type PasswordResetConfirmRequest struct {
+	Token            string `form:"token"`
+	Password         string `form:"password"`
+	PasswordRepeated string `form:"password-repeat"`
+}
+
+// Returns true if client didn't exceed mail requests limit.
+func allowMailRequest(ec echo.Context) bool {
+	var key string
+	if ip := h.ClientIP(ec.Request()); ip != nil {
+		key = ip.String()
+	}
+
+	return mailRequestsLimiter.Allow(key)
+}
+
+// Shows "too many requests" page.
+func tooManyRequests(ec echo.Context) error {
+	return noticePage(ec, http.StatusTooManyRequests, "Too many requests", "Too many requests were made from your address, please try again later.")
+}
+
+func resetPasswordGET(ec echo.Context) error {
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	return ec.HTML(http.StatusOK, templater.GetTemplate(ec, "users/reset_password.html", nil))
+}
+
+// Sends password reset link. Response is same whether such user exists
+// or not, so this form can't be used to find out registered emails.
+func resetPasswordPOST(ec echo.Context) error {
+	if ec.Get("AUTHORIZED").(bool) {
+		return ec.Redirect(http.StatusMovedPermanently, "/already_logged_in/")
+	}
+
+	req := &PasswordResetRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return ec.HTML(http.StatusBadRequest, templater.GetTemplate(ec, "users/reset_password.html", nil))
+	}
+
+	if !allowMailRequest(ec) {
+		log.Warn().Msgf("Password reset for '%s' was rate limited", email)
+		return tooManyRequests(ec)
+	}
+
+	u := GetUser(email)
+	if u != nil && u.IsActive && u.IsLocal() && time.Since(lastTokenIssuedAt(u.ID, TokenPasswordReset)) > passwordResetInterval {
+		if err := sendPasswordResetMail(u); err != nil {
+			log.Error().Msgf("Failed to issue password reset token for user '%s': %s", u.Login, err.Error())
+		}
+	}
+
+	return noticePage(ec, http.StatusOK, "Password reset link sent", "If account with email <b>"+html.EscapeString(email)+"</b> exists - link for password reset was sent to it.")
+}
+
+// Issues password reset token and sends it to user.
+func sendPasswordResetMail(u *User) error {
+	validity := settings.Int("password_reset.token_validity_minutes")
+	token, err := issueToken(u, TokenPasswordReset, time.Duration(validity)*time.Minute)
+	if err != nil {
+		return err
+	}
+
+	mailsender.SendMail("mail/password_reset.txt", map[string]string{
+		"mail.to":       u.Email,
+		"mail.subject":  "Password reset",
+		"user.login":    u.Login,
+		"reset.link":    absoluteURL("/reset_password/confirm/", url.Values{"token": {token}}),
+		"reset.minutes": strconv.Itoa(validity),
+	})
+
+	return nil
+}
+
+// Returns new password form.
+func resetPasswordForm(ec echo.Context, token string, errors []string) string {
+	return templater.GetTemplate(ec, "users/reset_password_confirm.html", map[string]string{
+		"errorsDiv": templater.GetErrorFlash(ec, errors),
+		"token":     html.EscapeString(token),
+	})
+}
+
+// Returns page shown for invalid or expired reset link.
+func invalidResetLink(ec echo.Context) error {
+	return noticePage(ec, http.StatusBadRequest, "Password reset failed", "Password reset link is invalid, was already used or has expired. <a href=\"/reset_password/\">Request new one</a>.")
+}
+
+func resetPasswordConfirmGET(ec echo.Context) error {
+	token := ec.QueryParam("token")
+	if !isTokenValid(token, TokenPasswordReset) {
+		return invalidResetLink(ec)
+	}
+
+	return ec.HTML(http.StatusOK, resetPasswordForm(ec, token, nil))
+}
+
+// Sets new password and logs user out everywhere, because whoever knew
+// old password might be logged in.
+func resetPasswordConfirmPOST(ec echo.Context) error {
+	req := &PasswordResetConfirmRequest{}
+	if err := ec.Bind(req); err != nil {
+		log.Error().Msgf("Failed to read form data: %s", err.Error())
+	}
+
+	if !isTokenValid(req.Token, TokenPasswordReset) {
+		return invalidResetLink(ec)
+	}
+
+	var errors []string
+	if req.Password == "" {
+		errors = append(errors, "Password should not be empty.")
+	} else if req.Password != req.PasswordRepeated {
+		errors = append(errors, "Passwords doesn't match.")
+	}
+
+	if len(errors) != 0 {
+		return ec.HTML(http.StatusBadRequest, resetPasswordForm(ec, req.Token, errors))
+	}
+
+	u, err := useToken(req.Token, TokenPasswordReset)
+	if err != nil {
+		log.Warn().Msgf("Password reset failed: %s", err.Error())
+		return invalidResetLink(ec)
+	}
+
+	if err1 := u.CreatePassword(req.Password); err1 != nil {
+		log.Error().Msgf("Failed to hash new password of user '%s': %s", u.Login, err1.Error())
+		return ec.HTML(http.StatusBadRequest, resetPasswordForm(ec, req.Token, []string{"Failed to change password: " + html.EscapeString(err1.Error())}))
+	}
+	u.MustChangePassword = false
+	u.Save()
+
+	if err1 := sessionkeys.DeleteUserSessions(u.ID); err1 != nil {
+		log.Error().Msgf("Failed to delete sessions of user #%d: %s", u.ID, err1.Error())
+	}
+
+	audit.Log(ec, &audit.Event{ActorID: u.ID, ActorLogin: u.Login, Action: audit.ActionPasswordReset, TargetType: audit.TargetUser, TargetID: u.ID, Target: u.Login}, nil, nil)
+
+	return noticePage(ec, http.StatusOK, "Password changed", "Your password was changed and all your sessions were closed. Now you can <a href=\"/login/\">log in</a> with new password.")
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// other
+	"github.com/labstack/echo"
+)
+
+// User roles.
+const (
+	// Can do everything.
+	RoleAdmin = "admin"
+	// Manages packages, routing and webhooks.
+	RoleMaintainer = "maintainer"
+	// Views packages and audit log.
+	RoleAuditor = "auditor"
+	// Views packages.
+	RoleViewer = "viewer"
+)
+
+// Roles is a list of all roles, from most to least powerful.
+var Roles = []string{RoleAdmin, RoleMaintainer, RoleAuditor, RoleViewer}
+
+// Role which users get if nothing else was requested.
+const DefaultRole = RoleViewer
+
+// Permissions.
+const (
+	PermDashboardView  = "dashboard.view"
+	PermPackagesView   = "packages.view"
+	PermPackagesManage = "packages.manage"
+	PermWebhooksManage = "webhooks.manage"
+	PermAuditView      = "audit.view"
+	PermUsersManage    = "users.manage"
+	PermSettingsManage = "settings.manage"
+)
+
+// Which roles has which permissions.
+var permissions = map[string][]string{
+	RoleAdmin:      {PermDashboardView, PermPackagesView, PermPackagesManage, PermWebhooksManage, PermAuditView, PermUsersManage, PermSettingsManage},
+	RoleMaintainer: {PermDashboardView, PermPackagesView, PermPackagesManage, PermWebhooksManage},
+	RoleAuditor:    {PermDashboardView, PermPackagesView, PermAuditView},
+	RoleViewer:     {PermDashboardView, PermPackagesView},
+}
+
+// IsValidRole returns true if passed role is known.
+func IsValidRole(role string) bool {
+	_, known := permissions[role]
+	return known
+}
+
+// RoleHasPermission returns true if passed role grants passed
+// permission.
+func RoleHasPermission(role string, permission string) bool {
+	for _, p := range permissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Can returns true if user has passed permission. Inactive users can't
+// do anything.
+func (u *User) Can(permission string) bool {
+	return u.IsActive && RoleHasPermission(u.Role, permission)
+}
+
+// CurrentUserCan returns true if currently logged in user has passed
+// permission. Requests authorized with access token are also limited
+// by token's scopes.
+func CurrentUserCan(ec echo.Context, permission string) bool {
+	if t := CurrentAccessToken(ec); t != nil && !t.Allows(permission) {
+		return false
+	}
+
+	u := GetCurrentlyLoggedInUser(ec)
+	return u != nil && u.Can(permission)
+}
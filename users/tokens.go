@@ -0,0 +1,165 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"errors"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Token purposes.
+const (
+	// Account activation after self-registration.
+	TokenActivation = "activation"
+	// Forgotten password reset.
+	TokenPasswordReset = "password_reset"
+	// Login which passed password check and waits for second factor.
+	TokenLoginChallenge = "login_challenge"
+)
+
+// Token is a one-time token which is sent to user. Only token's hash is
+// stored.
+type Token struct {
+	ID        int       `db:"id"`
+	UserID    int       `db:"user_id"`
+	Purpose   string    `db:"purpose"`
+	TokenHash string    `db:"token_hash"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Issues new token for passed purpose. Previously issued tokens for
+// same purpose stop working.
+func issueToken(u *User, purpose string, validity time.Duration) (string, error) {
+	token, err := helpers.GenerateToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err1 := deleteTokens(u.ID, purpose); err1 != nil {
+		return "", err1
+	}
+
+	now := time.Now().UTC()
+	t := &Token{
+		UserID:    u.ID,
+		Purpose:   purpose,
+		TokenHash: helpers.HashToken(token),
+		ExpiresAt: now.Add(validity),
+		CreatedAt: now,
+	}
+
+	_, err2 := database.DB.NamedExec("INSERT INTO `users_tokens` (user_id, purpose, token_hash, expires_at, created_at) VALUES (:user_id, :purpose, :token_hash, :expires_at, :created_at)", t)
+	if err2 != nil {
+		return "", err2
+	}
+
+	return token, nil
+}
+
+// Returns user token was issued for and deletes token, so it can't be
+// used again. Token is used only by request which actually deleted it,
+// concurrent request with same token fails.
+func useToken(token string, purpose string) (*User, error) {
+	t, err := findToken(token, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err1 := database.DB.Exec(database.DB.Rebind("DELETE FROM `users_tokens` WHERE id=? AND token_hash=? AND purpose=?"), t.ID, t.TokenHash, purpose)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	deleted, err2 := res.RowsAffected()
+	if err2 != nil {
+		return nil, err2
+	}
+
+	if deleted != 1 {
+		return nil, errors.New("token is invalid or was already used")
+	}
+
+	if time.Now().UTC().After(t.ExpiresAt) {
+		return nil, errors.New("token has expired")
+	}
+
+	u := GetUserByID(t.UserID)
+	if u == nil {
+		return nil, errors.New("user wasn't found")
+	}
+
+	return u, nil
+}
+
+// Returns true if token might be used. Unlike useToken it leaves token
+// in place.
+func isTokenValid(token string, purpose string) bool {
+	t, err := findToken(token, purpose)
+	return err == nil && time.Now().UTC().Before(t.ExpiresAt)
+}
+
+// Returns token from database.
+func findToken(token string, purpose string) (*Token, error) {
+	if token == "" {
+		return nil, errors.New("token is empty")
+	}
+
+	t := &Token{}
+	err := database.DB.Get(t, database.DB.Rebind("SELECT * FROM `users_tokens` WHERE token_hash=? AND purpose=?"), helpers.HashToken(token), purpose)
+	if err != nil {
+		return nil, errors.New("token is invalid or was already used")
+	}
+
+	return t, nil
+}
+
+// Returns when last token for passed purpose was issued to user, zero
+// time if there is no such token.
+func lastTokenIssuedAt(uid int, purpose string) time.Time {
+	var issued time.Time
+	err := database.DB.Get(&issued, database.DB.Rebind("SELECT created_at FROM `users_tokens` WHERE user_id=? AND purpose=? ORDER BY created_at DESC LIMIT 1"), uid, purpose)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return issued
+}
+
+// Deletes user's tokens for passed purpose along with everyone's
+// expired tokens.
+func deleteTokens(uid int, purpose string) error {
+	if _, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `users_tokens` WHERE expires_at<?"), time.Now().UTC()); err != nil {
+		log.Error().Msgf("Failed to delete expired users tokens: %s", err.Error())
+	}
+
+	_, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `users_tokens` WHERE user_id=? AND purpose=?"), uid, purpose)
+	return err
+}
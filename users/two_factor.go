@@ -0,0 +1,254 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/settings"
+	"github.com/welltrainedfolks/magister/internal/totp"
+
+	// other
+	"github.com/rs/zerolog/log"
+	"github.com/skip2/go-qrcode"
+)
+
+// How many recovery codes user gets.
+const recoveryCodesCount = 10
+
+// RecoveryCode is a one-time code which might be used instead of TOTP
+// code if user lost authenticator. Only code's hash is stored.
+type RecoveryCode struct {
+	ID        int       `db:"id"`
+	UserID    int       `db:"user_id"`
+	CodeHash  string    `db:"code_hash"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// IsTwoFactorRequired returns true if user's role requires two-factor
+// authentication.
+func (u *User) IsTwoFactorRequired() bool {
+	for _, role := range settings.List("security.require_2fa_roles") {
+		if role == u.Role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Saves two-factor authentication data.
+func (u *User) saveTwoFactor() error {
+	_, err := database.DB.NamedExec("UPDATE `users` SET totp_secret=:totp_secret, totp_enabled=:totp_enabled, totp_last_step=:totp_last_step WHERE id=:id", u)
+	return err
+}
+
+// StartTwoFactorEnrolment generates new TOTP secret. Two-factor
+// authentication isn't enabled until first code is verified with
+// EnableTwoFactor.
+func (u *User) StartTwoFactorEnrolment() error {
+	if u.TOTPEnabled {
+		return errors.New("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return err
+	}
+
+	u.TOTPSecret = secret
+	u.TOTPLastStep = 0
+	return u.saveTwoFactor()
+}
+
+// EnableTwoFactor finishes enrolment if passed code is valid and
+// returns recovery codes.
+func (u *User) EnableTwoFactor(code string) ([]string, error) {
+	if u.TOTPEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	if u.TOTPSecret == "" {
+		return nil, errors.New("enrolment wasn't started")
+	}
+
+	if !u.checkTOTP(code) {
+		return nil, errors.New("code is invalid")
+	}
+
+	u.TOTPEnabled = true
+	if err := u.saveTwoFactor(); err != nil {
+		return nil, err
+	}
+
+	return u.GenerateRecoveryCodes()
+}
+
+// DisableTwoFactor turns two-factor authentication off and deletes
+// recovery codes.
+func (u *User) DisableTwoFactor() error {
+	u.TOTPSecret = ""
+	u.TOTPEnabled = false
+	u.TOTPLastStep = 0
+	if err := u.saveTwoFactor(); err != nil {
+		return err
+	}
+
+	_, err := database.DB.NamedExec("DELETE FROM `users_recovery_codes` WHERE user_id=:id", u)
+	return err
+}
+
+// CheckSecondFactor checks TOTP or recovery code. Used recovery code
+// is deleted, "recovery" is true if it was used.
+func (u *User) CheckSecondFactor(code string) (recovery bool, ok bool) {
+	if !u.TOTPEnabled {
+		return false, false
+	}
+
+	if u.checkTOTP(code) {
+		return false, true
+	}
+
+	if u.useRecoveryCode(code) {
+		return true, true
+	}
+
+	return false, false
+}
+
+// Checks TOTP code. Accepted code can't be used again: step is saved
+// only if it's after saved one, so of concurrent requests with same
+// code only one passes.
+func (u *User) checkTOTP(code string) bool {
+	step, ok := totp.Validate(u.TOTPSecret, code, time.Now(), u.TOTPLastStep)
+	if !ok {
+		return false
+	}
+
+	res, err := database.DB.Exec(database.DB.Rebind("UPDATE `users` SET totp_last_step=? WHERE id=? AND totp_last_step<?"), step, u.ID, step)
+	if err != nil {
+		log.Error().Msgf("Failed to save TOTP step of user #%d: %s", u.ID, err.Error())
+		return false
+	}
+
+	updated, err1 := res.RowsAffected()
+	if err1 != nil {
+		log.Error().Msgf("Failed to save TOTP step of user #%d: %s", u.ID, err1.Error())
+		return false
+	}
+
+	if updated != 1 {
+		log.Warn().Msgf("TOTP code of user #%d was already used", u.ID)
+		return false
+	}
+
+	u.TOTPLastStep = step
+	return true
+}
+
+// TwoFactorURL returns otpauth:// URL for authenticator apps.
+func (u *User) TwoFactorURL() string {
+	return totp.URL(settings.String("site.name"), u.Login, u.TOTPSecret)
+}
+
+// TwoFactorQR returns QR code with otpauth:// URL as data URI which
+// might be used as image source.
+func (u *User) TwoFactorQR() (string, error) {
+	png, err := qrcode.Encode(u.TwoFactorURL(), qrcode.Medium, 256)
+	if err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
+
+// GenerateRecoveryCodes replaces user's recovery codes with new ones.
+func (u *User) GenerateRecoveryCodes() ([]string, error) {
+	if _, err := database.DB.NamedExec("DELETE FROM `users_recovery_codes` WHERE user_id=:id", u); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		token, err := helpers.GenerateToken(5)
+		if err != nil {
+			return nil, err
+		}
+
+		code := token[:5] + "-" + token[5:]
+		rc := &RecoveryCode{
+			UserID:    u.ID,
+			CodeHash:  helpers.HashToken(normalizeRecoveryCode(code)),
+			CreatedAt: time.Now().UTC(),
+		}
+
+		if _, err1 := database.DB.NamedExec("INSERT INTO `users_recovery_codes` (user_id, code_hash, created_at) VALUES (:user_id, :code_hash, :created_at)", rc); err1 != nil {
+			return nil, err1
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// CountRecoveryCodes returns count of user's unused recovery codes.
+func (u *User) CountRecoveryCodes() int {
+	var count int
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `users_recovery_codes` WHERE user_id=?"), u.ID)
+	if err != nil {
+		log.Error().Msgf("Failed to count recovery codes of user #%d: %s", u.ID, err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// Deletes passed recovery code and returns true if it existed.
+func (u *User) useRecoveryCode(code string) bool {
+	code = normalizeRecoveryCode(code)
+	if code == "" {
+		return false
+	}
+
+	res, err := database.DB.Exec(database.DB.Rebind("DELETE FROM `users_recovery_codes` WHERE user_id=? AND code_hash=?"), u.ID, helpers.HashToken(code))
+	if err != nil {
+		log.Error().Msgf("Failed to use recovery code of user #%d: %s", u.ID, err.Error())
+		return false
+	}
+
+	deleted, _ := res.RowsAffected()
+	return deleted == 1
+}
+
+// Recovery codes are accepted regardless of case, spaces and dashes.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
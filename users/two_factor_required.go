@@ -0,0 +1,65 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package users
+
+import (
+	// stdlib
+	"net/http"
+	"strings"
+
+	// other
+	"github.com/labstack/echo"
+)
+
+// Where users which must set up two-factor authentication are sent to.
+const twoFactorSetupPath = "/profile/2fa/"
+
+// Paths which are available to users which must set up two-factor
+// authentication.
+var twoFactorAllowedPaths = []string{twoFactorSetupPath, passwordChangePath, "/logout/", "/static/"}
+
+// Redirects users which role requires two-factor authentication, but
+// who haven't set it up, to two-factor authentication setup.
+func twoFactorEnforcer() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ec echo.Context) error {
+			authorized, _ := ec.Get("AUTHORIZED").(bool)
+			if !authorized {
+				return next(ec)
+			}
+
+			path := ec.Request().URL.Path
+			for _, allowed := range twoFactorAllowedPaths {
+				if strings.HasPrefix(path, allowed) {
+					return next(ec)
+				}
+			}
+
+			u := GetCurrentlyLoggedInUser(ec)
+			if u != nil && !u.TOTPEnabled && u.IsTwoFactorRequired() {
+				return ec.Redirect(http.StatusFound, twoFactorSetupPath)
+			}
+
+			return next(ec)
+		}
+	}
+}
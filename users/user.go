@@ -23,30 +23,55 @@ package users
 
 import (
 	// stdlib
-	"crypto/sha256"
-	"fmt"
+	"errors"
+	"strings"
 	"time"
 
 	// local
 	"github.com/welltrainedfolks/magister/internal/database"
-	"github.com/welltrainedfolks/magister/internal/helpers"
+	"github.com/welltrainedfolks/magister/internal/passwords"
+	"github.com/welltrainedfolks/magister/internal/sessionkeys"
+	"github.com/welltrainedfolks/magister/internal/settings"
 
 	// other
 	"github.com/labstack/echo"
 	"github.com/rs/zerolog/log"
-	"golang.org/x/crypto/scrypt"
 )
 
-// User represents single user in system.
+// User represents single user in system. User with
+// MustChangePassword set can't do anything except changing password.
+// Role decides what user can do in admin interface. AuthProvider
+// tells who checks user's credentials, users from external providers
+// have no local password. OpenID Connect users are identified by
+// issuer and subject, which never change. RegistrationState
+// tells what self-registered user which isn't active yet waits for.
+// TOTP secret is kept while enrolment isn't finished, two-factor
+// authentication is required only when TOTPEnabled is set.
+// LastLoginAt is nil if user never logged in. Failed logins in a row
+// are counted to slow down password guessing and lock account for a
+// while when there are too many of them.
 type User struct {
-	ID           int       `db:"id"`
-	Login        string    `db:"login"`
-	Email        string    `db:"email"`
-	Password     string    `db:"password"`
-	PasswordSalt string    `db:"password_salt"`
-	IsActive     bool      `db:"is_active"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
+	ID                 int        `db:"id"`
+	Login              string     `db:"login"`
+	Email              string     `db:"email"`
+	Role               string     `db:"role"`
+	AuthProvider       string     `db:"auth_provider"`
+	OIDCIssuer         string     `db:"oidc_issuer"`
+	OIDCSubject        string     `db:"oidc_subject"`
+	Password           string     `db:"password"`
+	PasswordSalt       string     `db:"password_salt"`
+	TOTPSecret         string     `db:"totp_secret"`
+	TOTPEnabled        bool       `db:"totp_enabled"`
+	TOTPLastStep       int64      `db:"totp_last_step"`
+	IsActive           bool       `db:"is_active"`
+	MustChangePassword bool       `db:"must_change_password"`
+	RegistrationState  string     `db:"registration_state"`
+	LastLoginAt        *time.Time `db:"last_login_at"`
+	FailedLogins       int        `db:"failed_logins"`
+	LastFailedLoginAt  *time.Time `db:"last_failed_login_at"`
+	LockedUntil        *time.Time `db:"locked_until"`
+	CreatedAt          time.Time  `db:"created_at"`
+	UpdatedAt          time.Time  `db:"updated_at"`
 }
 
 // GetCurrentlyLoggedInUser returns user data based on echo's Context
@@ -58,7 +83,7 @@ func GetCurrentlyLoggedInUser(ec echo.Context) *User {
 	user := &User{}
 	err := database.DB.Get(user, database.DB.Rebind("SELECT * FROM `users` WHERE id=?"), ec.Get("UID").(int))
 	if err != nil {
-		log.Error().Msgf("Failed to get user with ID '%s': %s", ec.Get("UID").(int), err.Error())
+		log.Error().Msgf("Failed to get user with ID '%d': %s", ec.Get("UID").(int), err.Error())
 		return nil
 	}
 
@@ -76,6 +101,83 @@ func GetCurrentlyLoggedInUserName(ec echo.Context) string {
 	return u.Email
 }
 
+// GetUsers returns all users from database.
+func GetUsers() []*User {
+	users := []*User{}
+	err := database.DB.Select(&users, "SELECT * FROM `users` ORDER BY `id`")
+	if err != nil {
+		log.Error().Msgf("Failed to get users list: %s", err.Error())
+		return nil
+	}
+
+	return users
+}
+
+// SearchUsers returns page of users which login or email contains
+// passed query. Empty query matches everything.
+func SearchUsers(query string, offset int, limit int) []*User {
+	users := []*User{}
+	like := "%" + database.EscapeLike(query) + "%"
+	err := database.DB.Select(&users, database.DB.Rebind("SELECT * FROM `users` WHERE login LIKE ? OR email LIKE ? ORDER BY `login` LIMIT ? OFFSET ?"), like, like, limit, offset)
+	if err != nil {
+		log.Error().Msgf("Failed to search users: %s", err.Error())
+		return nil
+	}
+
+	return users
+}
+
+// CountUsers returns count of users which login or email contains
+// passed query.
+func CountUsers(query string) int {
+	var count int
+	like := "%" + database.EscapeLike(query) + "%"
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `users` WHERE login LIKE ? OR email LIKE ?"), like, like)
+	if err != nil {
+		log.Error().Msgf("Failed to count users: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// CountActiveAdmins returns count of active users with admin role.
+func CountActiveAdmins() int {
+	var count int
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `users` WHERE is_active=1 AND role=?"), RoleAdmin)
+	if err != nil {
+		log.Error().Msgf("Failed to count active admins: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// CountUsersAwaitingApproval returns count of self-registered users
+// which wait for administrator's approval.
+func CountUsersAwaitingApproval() int {
+	var count int
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `users` WHERE registration_state=?"), RegistrationAwaitingApproval)
+	if err != nil {
+		log.Error().Msgf("Failed to count users awaiting approval: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
+// CountActiveUsers returns count of users which are able to log in.
+func CountActiveUsers() int {
+	var count int
+	err := database.DB.Get(&count, "SELECT COUNT(*) FROM `users` WHERE is_active=1")
+	if err != nil {
+		log.Error().Msgf("Failed to count active users: %s", err.Error())
+		return 0
+	}
+
+	return count
+}
+
 // GetUser returns user data from database.
 func GetUser(email string) *User {
 	user := &User{}
@@ -115,94 +217,212 @@ func GetUserByLogin(login string) *User {
 	return user
 }
 
-// NewUser creates user in database.
-func NewUser(login, email, password string) *User {
+// GetUserByOIDCSubject returns user which OpenID Connect issuer knows
+// under passed subject.
+func GetUserByOIDCSubject(issuer string, subject string) *User {
+	user := &User{}
+	err := database.DB.Get(user, database.DB.Rebind("SELECT * FROM `users` WHERE auth_provider=? AND oidc_issuer=? AND oidc_subject=?"), ProviderOIDC, issuer, subject)
+	if err != nil {
+		log.Debug().Msgf("No user with OpenID Connect subject '%s' at '%s': %s", subject, issuer, err.Error())
+		return nil
+	}
+
+	return user
+}
+
+// NewUser creates user in database. Empty role means default one.
+func NewUser(login, email, password, role string) *User {
 	u := &User{}
 	u.Login = login
 	u.Email = email
-	u.CreatePassword(password)
+	u.Role = role
+	if err := u.CreatePassword(password); err != nil {
+		log.Error().Msgf("Failed to hash new user's password: %s", err.Error())
+		return nil
+	}
 	u.IsActive = false
 	u.CreatedAt = time.Now().UTC()
 	u.UpdatedAt = time.Now().UTC()
 
 	// Create user in database.
-	res, err := database.DB.NamedExec("INSERT INTO `users` (login, email, password, password_salt, is_active, created_at, updated_at) VALUES (:login, :email, :password, :password_salt, :is_active, :created_at, :updated_at)", u)
-	if err != nil {
+	if err := u.Create(); err != nil {
 		log.Error().Msgf("Failed to create new user: %s", err.Error())
 		return nil
 	}
 
-	lastInsertedID, err1 := res.LastInsertId()
-	if err1 != nil {
-		log.Error().Msgf("Failed to get last inserted ID for user insertion: %s", err1.Error())
-		return nil
-	}
-
-	u.ID = int(lastInsertedID)
 	return u
 }
 
-// CheckPassword checks password validity.
+// CheckPassword checks password validity. Hashes made before PHC
+// strings were introduced are checked with their separate salt.
 func (u *User) CheckPassword(password string) bool {
-	if u.Password == "" || u.PasswordSalt == "" {
+	if u.Password == "" {
 		return false
 	}
 
-	pass := u.hashPassword(password, u.PasswordSalt)
+	if passwords.IsLegacy(u.Password) {
+		return passwords.VerifyLegacy(password, u.PasswordSalt, u.Password)
+	}
 
-	if pass == u.Password {
-		return true
+	ok, err := passwords.Verify(password, u.Password)
+	if err != nil {
+		log.Error().Msgf("Failed to check password of user '%s': %s", u.Login, err.Error())
 	}
 
-	return false
+	return ok
 }
 
-// CreatePassword creates password and hash.
-func (u *User) CreatePassword(password string) {
+// CreatePassword hashes password with configured algorithm. Salt and
+// parameters are stored in hash itself.
+func (u *User) CreatePassword(password string) error {
 	log.Debug().Msg("Generating password hash...")
 
-	// Generate random string.
-	randomString := helpers.GenerateRandomString(64)
-	// Generate scrypt'd password salt.
-	passwordSaltBytes, _ := scrypt.Key([]byte(randomString), []byte(randomString), 32768, 8, 1, 32)
-	// Sha256 it!
-	passwordSaltSha256Bytes := sha256.Sum256(passwordSaltBytes)
-	// Stringify it.
-	u.PasswordSalt = fmt.Sprintf("%x", passwordSaltSha256Bytes[:])
+	hash, err := passwords.Hash(password, settings.String("security.password_hash"))
+	if err != nil {
+		return err
+	}
+
+	u.Password = hash
+	u.PasswordSalt = ""
+	return nil
+}
+
+// Replaces legacy hash, or hash made with other algorithm or parameters,
+// with current one. Password is known only after successful login, so
+// that's the only time it might be done.
+func (u *User) rehashPassword(password string) {
+	if !passwords.NeedsRehash(u.Password, settings.String("security.password_hash")) {
+		return
+	}
+
+	if err := u.CreatePassword(password); err != nil {
+		log.Error().Msgf("Failed to rehash password of user '%s': %s", u.Login, err.Error())
+		return
+	}
+
+	u.Save()
+	log.Info().Msgf("Password hash of user '%s' was upgraded", u.Login)
+}
+
+// Create inserts user into database as is. Unlike NewUser it does not
+// touch password and timestamps, which is useful when user data comes
+// from somewhere else (e.g. from registry dump). User without role gets
+// default one.
+func (u *User) Create() error {
+	if u.Role == "" {
+		u.Role = DefaultRole
+	}
+
+	if u.AuthProvider == "" {
+		u.AuthProvider = ProviderLocal
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `users` (login, email, role, auth_provider, oidc_issuer, oidc_subject, password, password_salt, is_active, must_change_password, registration_state, created_at, updated_at) VALUES (:login, :email, :role, :auth_provider, :oidc_issuer, :oidc_subject, :password, :password_salt, :is_active, :must_change_password, :registration_state, :created_at, :updated_at)", u)
+	if err != nil {
+		return err
+	}
 
-	u.Password = u.hashPassword(password, u.PasswordSalt)
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
 
+	u.ID = int(lastInsertedID)
+	return nil
 }
 
-// Delete deletes current user from database.
+// Delete deletes current user from database. User is logged out
+// everywhere.
 func (u *User) Delete() error {
+	if _, err := database.DB.NamedExec("DELETE FROM `users_tokens` WHERE user_id=:id", u); err != nil {
+		return err
+	}
+
+	if _, err := database.DB.NamedExec("DELETE FROM `users_recovery_codes` WHERE user_id=:id", u); err != nil {
+		return err
+	}
+
+	if err := u.deleteAccessTokens(); err != nil {
+		return err
+	}
+
+	if err := sessionkeys.DeleteUserSessions(u.ID); err != nil {
+		return err
+	}
+
 	_, err := database.DB.NamedExec("DELETE FROM users WHERE login=:login", u)
 	return err
 }
 
-// Hashes provided password and returns it's hashed and crypted value.
-func (u *User) hashPassword(password string, salt string) string {
-	// Generate scrypt'd password itself.
-	passwordBytes, _ := scrypt.Key([]byte(password), []byte(u.PasswordSalt), 32768, 8, 1, 32)
-	// Sha256 it and stringify.
-	passwordBytesSha256 := sha256.Sum256(passwordBytes)
-	passwordHashString := fmt.Sprintf("%x", passwordBytesSha256[:])
-	return passwordHashString
-}
-
 // Save saves user.
 func (u *User) Save() {
 	u.UpdatedAt = time.Now().UTC()
-	_, err := database.DB.NamedExec("UPDATE `users` SET password=:password, password_salt=:password_salt, is_active=:is_active, updated_at=:updated_at WHERE id=:id", u)
+	_, err := database.DB.NamedExec("UPDATE `users` SET login=:login, email=:email, role=:role, auth_provider=:auth_provider, oidc_issuer=:oidc_issuer, oidc_subject=:oidc_subject, password=:password, password_salt=:password_salt, is_active=:is_active, must_change_password=:must_change_password, registration_state=:registration_state, updated_at=:updated_at WHERE id=:id", u)
 	if err != nil {
 		log.Error().Msgf("Failed to update user's data in database: %s", err.Error())
 	}
 }
 
-// SetActive sets user's active status.
+// Validate checks user's login, email and role. Login and email should
+// be unique.
+func (u *User) Validate() error {
+	if strings.TrimSpace(u.Login) == "" {
+		return errors.New("login should not be empty")
+	}
+
+	if u.Role != "" && !IsValidRole(u.Role) {
+		return errors.New("unknown role '" + u.Role + "'")
+	}
+
+	if !strings.Contains(u.Email, "@") || strings.ContainsAny(u.Email, " \t") {
+		return errors.New("email is invalid")
+	}
+
+	var count int
+	err := database.DB.Get(&count, database.DB.Rebind("SELECT COUNT(*) FROM `users` WHERE (login=? OR email=?) AND id<>?"), u.Login, u.Email, u.ID)
+	if err != nil {
+		return err
+	}
+
+	if count != 0 {
+		return errors.New("user with same login or email already exists")
+	}
+
+	return nil
+}
+
+// AuditData returns user's fields which are written into audit log.
+// Password hashes are never written.
+func (u *User) AuditData() map[string]interface{} {
+	return map[string]interface{}{
+		"login":                u.Login,
+		"email":                u.Email,
+		"role":                 u.Role,
+		"auth_provider":        u.AuthProvider,
+		"is_active":            u.IsActive,
+		"must_change_password": u.MustChangePassword,
+		"registration_state":   u.RegistrationState,
+		"totp_enabled":         u.TOTPEnabled,
+		"locked_until":         u.LockedUntil,
+	}
+}
+
+// UpdateLastLogin remembers that user has just logged in.
+func (u *User) UpdateLastLogin() {
+	now := time.Now().UTC()
+	u.LastLoginAt = &now
+	_, err := database.DB.NamedExec("UPDATE `users` SET last_login_at=:last_login_at WHERE id=:id", u)
+	if err != nil {
+		log.Error().Msgf("Failed to update user's last login time in database: %s", err.Error())
+	}
+}
+
+// SetActive sets user's active status. Self-registered user doesn't
+// wait for anything after that.
 func (u *User) SetActive() {
 	u.IsActive = true
-	_, err := database.DB.NamedExec("UPDATE `users` SET is_active=:is_active WHERE id=:id", u)
+	u.RegistrationState = ""
+	_, err := database.DB.NamedExec("UPDATE `users` SET is_active=:is_active, registration_state=:registration_state WHERE id=:id", u)
 	if err != nil {
 		log.Error().Msgf("Failed to set user's active status in database: %s", err.Error())
 	}
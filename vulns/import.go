@@ -0,0 +1,205 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vulns
+
+import (
+	// stdlib
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ImportReport describes what was imported.
+type ImportReport struct {
+	Created int
+	Updated int
+	Skipped int
+	// Files which failed to import, with reasons.
+	Failed []string
+}
+
+// Import imports OSV advisories from directory, zip or tar.gz archive
+// or single JSON file. Directory "index" (as in Go vulnerability
+// database) and files which aren't JSON are ignored.
+func Import(path string, source string) (*ImportReport, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{}
+	lowerPath := strings.ToLower(path)
+
+	switch {
+	case fi.IsDir():
+		err = importDirectory(report, path, source)
+	case strings.HasSuffix(lowerPath, ".zip"):
+		err = importZip(report, path, source)
+	case strings.HasSuffix(lowerPath, ".tar.gz") || strings.HasSuffix(lowerPath, ".tgz"):
+		err = importTarGz(report, path, source)
+	default:
+		var data []byte
+		data, err = ioutil.ReadFile(path)
+		if err == nil {
+			importFile(report, path, data, source)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Returns true if file with passed path might be an advisory.
+func isAdvisoryFile(path string) bool {
+	path = filepath.ToSlash(path)
+	if !strings.HasSuffix(strings.ToLower(path), ".json") {
+		return false
+	}
+
+	for _, part := range strings.Split(path, "/") {
+		if part == "index" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func importDirectory(report *ImportReport, dir string, source string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(dir, path)
+		if info.IsDir() || !isAdvisoryFile(rel) {
+			return nil
+		}
+
+		data, err1 := ioutil.ReadFile(path)
+		if err1 != nil {
+			return err1
+		}
+
+		importFile(report, path, data, source)
+		return nil
+	})
+}
+
+func importZip(report *ImportReport, path string, source string) error {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || !isAdvisoryFile(f.Name) {
+			continue
+		}
+
+		rc, err1 := f.Open()
+		if err1 != nil {
+			return err1
+		}
+
+		data, err2 := ioutil.ReadAll(rc)
+		rc.Close()
+		if err2 != nil {
+			return err2
+		}
+
+		importFile(report, path+": "+f.Name, data, source)
+	}
+
+	return nil
+}
+
+func importTarGz(report *ImportReport, path string, source string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err1 := gzip.NewReader(f)
+	if err1 != nil {
+		return err1
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err2 := tr.Next()
+		if err2 == io.EOF {
+			break
+		}
+		if err2 != nil {
+			return err2
+		}
+
+		if hdr.Typeflag != tar.TypeReg || !isAdvisoryFile(hdr.Name) {
+			continue
+		}
+
+		data, err3 := ioutil.ReadAll(tr)
+		if err3 != nil {
+			return err3
+		}
+
+		importFile(report, path+": "+hdr.Name, data, source)
+	}
+
+	return nil
+}
+
+// Imports single advisory.
+func importFile(report *ImportReport, name string, data []byte, source string) {
+	entry, err := ParseEntry(data)
+	if err != nil {
+		report.Failed = append(report.Failed, name+": "+err.Error())
+		return
+	}
+
+	result, err1 := SaveEntry(entry, data, source)
+	if err1 != nil {
+		report.Failed = append(report.Failed, name+": "+err1.Error())
+		return
+	}
+
+	switch result {
+	case SaveCreated:
+		report.Created++
+	case SaveUpdated:
+		report.Updated++
+	default:
+		report.Skipped++
+	}
+}
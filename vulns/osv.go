@@ -0,0 +1,258 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vulns
+
+import (
+	// stdlib
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/helpers"
+)
+
+// Ecosystem which Go modules advisories belong to.
+const EcosystemGo = "Go"
+
+// Entry is an advisory in OSV format (https://ossf.github.io/osv-schema/).
+// Only fields MAGISTER uses are described here, advisory itself is
+// stored and served as it was imported.
+type Entry struct {
+	ID        string     `json:"id"`
+	Modified  time.Time  `json:"modified"`
+	Published time.Time  `json:"published"`
+	Withdrawn *time.Time `json:"withdrawn,omitempty"`
+	Aliases   []string   `json:"aliases,omitempty"`
+	Summary   string     `json:"summary,omitempty"`
+	Details   string     `json:"details,omitempty"`
+	Affected  []Affected `json:"affected"`
+}
+
+// Affected describes affected package and it's versions.
+type Affected struct {
+	Package AffectedPackage `json:"package"`
+	Ranges  []AffectedRange `json:"ranges,omitempty"`
+}
+
+// AffectedPackage is a package (module for Go) which is affected.
+type AffectedPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// AffectedRange is a list of versions events. Only SEMVER ranges are
+// supported.
+type AffectedRange struct {
+	Type   string       `json:"type"`
+	Events []RangeEvent `json:"events"`
+}
+
+// RangeEvent is either version where vulnerability was introduced or
+// version where it was fixed.
+type RangeEvent struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// ParseEntry parses advisory in OSV format.
+func ParseEntry(data []byte) (*Entry, error) {
+	entry := &Entry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+
+	if entry.ID == "" {
+		return nil, errors.New("advisory has no id")
+	}
+
+	if entry.Modified.IsZero() {
+		return nil, errors.New("advisory " + entry.ID + " has no modification time")
+	}
+
+	return entry, nil
+}
+
+// Modules returns paths of Go modules affected by advisory.
+func (e *Entry) Modules() []string {
+	var modules []string
+	seen := make(map[string]bool)
+	for _, a := range e.Affected {
+		if a.Package.Ecosystem != EcosystemGo || a.Package.Name == "" || seen[a.Package.Name] {
+			continue
+		}
+
+		seen[a.Package.Name] = true
+		modules = append(modules, a.Package.Name)
+	}
+
+	return modules
+}
+
+// Affects returns true if passed version of module is affected. Withdrawn
+// advisories affect nothing.
+func (e *Entry) Affects(module string, version string) bool {
+	if e.Withdrawn != nil {
+		return false
+	}
+
+	for _, a := range e.Affected {
+		if a.Package.Ecosystem != EcosystemGo || a.Package.Name != module {
+			continue
+		}
+
+		// No ranges means every version is affected.
+		if len(a.Ranges) == 0 {
+			return true
+		}
+
+		for _, r := range a.Ranges {
+			if r.Type == "SEMVER" && inRange(r.Events, version) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AffectedVersions returns which of passed versions of module are
+// affected.
+func (e *Entry) AffectedVersions(module string, versions []string) []string {
+	var affected []string
+	for _, version := range versions {
+		if e.Affects(module, version) {
+			affected = append(affected, version)
+		}
+	}
+
+	return affected
+}
+
+// DescribeAffected returns which of passed versions of module are
+// affected, e.g. "v1.2.0, v1.2.1". Nil versions mean they aren't known,
+// then advisory's ranges are described instead.
+func (e *Entry) DescribeAffected(module string, versions []string) string {
+	if versions == nil {
+		return "served versions aren't known, advisory affects " + strings.Join(e.DescribeRanges(module), "; ")
+	}
+
+	return strings.Join(e.AffectedVersions(module, versions), ", ")
+}
+
+// LatestFixed returns latest version of module in which vulnerability
+// was fixed or empty string if there is no fix.
+func (e *Entry) LatestFixed(module string) string {
+	var latest string
+	for _, a := range e.Affected {
+		if a.Package.Ecosystem != EcosystemGo || a.Package.Name != module {
+			continue
+		}
+
+		for _, r := range a.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" && (latest == "" || helpers.CompareVersions(event.Fixed, latest) > 0) {
+					latest = event.Fixed
+				}
+			}
+		}
+	}
+
+	return latest
+}
+
+// Returns true if version is within range described by events. Events
+// are walked in versions order: "introduced" opens affected range and
+// "fixed" closes it.
+func inRange(events []RangeEvent, version string) bool {
+	sorted := make([]RangeEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return helpers.CompareVersions(eventVersion(sorted[i]), eventVersion(sorted[j])) < 0
+	})
+
+	var affected bool
+	for _, event := range sorted {
+		if event.Introduced != "" && helpers.CompareVersions(version, event.Introduced) >= 0 {
+			affected = true
+		}
+
+		if event.Fixed != "" && helpers.CompareVersions(version, event.Fixed) >= 0 {
+			affected = false
+		}
+	}
+
+	return affected
+}
+
+func eventVersion(event RangeEvent) string {
+	if event.Introduced != "" {
+		return event.Introduced
+	}
+
+	return event.Fixed
+}
+
+// DescribeRanges returns human-readable affected versions ranges of
+// module, e.g. "from 1.2.0 before 1.4.1".
+func (e *Entry) DescribeRanges(module string) []string {
+	var ranges []string
+	for _, a := range e.Affected {
+		if a.Package.Ecosystem != EcosystemGo || a.Package.Name != module {
+			continue
+		}
+
+		if len(a.Ranges) == 0 {
+			ranges = append(ranges, "all versions")
+			continue
+		}
+
+		for _, r := range a.Ranges {
+			var current string
+			for _, event := range r.Events {
+				if event.Introduced != "" {
+					if event.Introduced == "0" {
+						current = "all versions"
+					} else {
+						current = "from " + event.Introduced
+					}
+				}
+
+				if event.Fixed != "" {
+					if current == "" {
+						current = "all versions"
+					}
+					ranges = append(ranges, current+" before "+event.Fixed)
+					current = ""
+				}
+			}
+
+			if current != "" {
+				ranges = append(ranges, current+" (no fix yet)")
+			}
+		}
+	}
+
+	return ranges
+}
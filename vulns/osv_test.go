@@ -0,0 +1,101 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vulns
+
+import (
+	// stdlib
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Advisory affecting go.example.com/lib from v1.2.0 before v1.4.1 and
+// from v2.0.0 without fix, in OSV notation without "v" prefix.
+func testEntry() *Entry {
+	return &Entry{
+		ID:       "GO-2024-0001",
+		Modified: time.Now(),
+		Affected: []Affected{
+			{
+				Package: AffectedPackage{Name: "go.example.com/lib", Ecosystem: EcosystemGo},
+				Ranges: []AffectedRange{
+					{Type: "SEMVER", Events: []RangeEvent{{Introduced: "1.2.0"}, {Fixed: "1.4.1"}, {Introduced: "2.0.0"}}},
+				},
+			},
+			{
+				Package: AffectedPackage{Name: "go.example.com/other", Ecosystem: EcosystemGo},
+			},
+		},
+	}
+}
+
+func TestAffectedVersions(t *testing.T) {
+	entry := testEntry()
+
+	versions := []string{"v1.1.9", "v1.2.0", "v1.3.0-rc.1", "v1.4.0", "v1.4.1", "v1.5.0", "v2.0.0-beta", "v2.0.0", "v2.3.0+incompatible"}
+	expected := []string{"v1.2.0", "v1.3.0-rc.1", "v1.4.0", "v2.0.0", "v2.3.0+incompatible"}
+
+	if affected := entry.AffectedVersions("go.example.com/lib", versions); !reflect.DeepEqual(affected, expected) {
+		t.Errorf("Expected %v to be affected, got %v", expected, affected)
+	}
+
+	// Module without ranges is affected in every version.
+	if affected := entry.AffectedVersions("go.example.com/other", []string{"v0.1.0", "v3.0.0"}); len(affected) != 2 {
+		t.Errorf("Expected every version of module without ranges to be affected, got %v", affected)
+	}
+
+	if affected := entry.AffectedVersions("go.example.com/unrelated", versions); len(affected) != 0 {
+		t.Errorf("Expected unrelated module not to be affected, got %v", affected)
+	}
+}
+
+func TestAffectedVersionsUnsortedEvents(t *testing.T) {
+	entry := testEntry()
+	entry.Affected[0].Ranges[0].Events = []RangeEvent{{Fixed: "1.4.1"}, {Introduced: "2.0.0"}, {Introduced: "0"}}
+
+	expected := []string{"v0.1.0", "v1.4.0", "v2.1.0"}
+	if affected := entry.AffectedVersions("go.example.com/lib", []string{"v0.1.0", "v1.4.0", "v1.4.1", "v1.9.0", "v2.1.0"}); !reflect.DeepEqual(affected, expected) {
+		t.Errorf("Expected %v to be affected, got %v", expected, affected)
+	}
+}
+
+func TestWithdrawnAffectsNothing(t *testing.T) {
+	entry := testEntry()
+	withdrawn := time.Now()
+	entry.Withdrawn = &withdrawn
+
+	if affected := entry.AffectedVersions("go.example.com/lib", []string{"v1.2.0"}); len(affected) != 0 {
+		t.Errorf("Expected withdrawn advisory to affect nothing, got %v", affected)
+	}
+}
+
+func TestDescribeAffected(t *testing.T) {
+	entry := testEntry()
+
+	if d := entry.DescribeAffected("go.example.com/lib", []string{"v1.2.0", "v1.5.0", "v2.0.0"}); d != "v1.2.0, v2.0.0" {
+		t.Errorf("Unexpected description of affected versions: %s", d)
+	}
+
+	if d := entry.DescribeAffected("go.example.com/lib", nil); d != "served versions aren't known, advisory affects from 1.2.0 before 1.4.1; from 2.0.0 (no fix yet)" {
+		t.Errorf("Unexpected description of unknown versions: %s", d)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vulns
+
+import (
+	// stdlib
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	// local
+	h "github.com/welltrainedfolks/magister/internal/http"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/labstack/echo"
+	"github.com/rs/zerolog/log"
+)
+
+// Prefix for vulnerability database endpoints. "govulncheck -db
+// https://go.example.com/vulndb" uses it.
+const vulndbPrefix = "/vulndb/"
+
+// Go vulnerability database index entries, see
+// https://go.dev/security/vuln/database#api.
+type dbIndex struct {
+	Modified time.Time `json:"modified"`
+}
+
+type moduleIndexEntry struct {
+	Path  string             `json:"path"`
+	Vulns []moduleIndexVulns `json:"vulns"`
+}
+
+type moduleIndexVulns struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+	Fixed    string    `json:"fixed,omitempty"`
+}
+
+type vulnIndexEntry struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+	Aliases  []string  `json:"aliases,omitempty"`
+}
+
+// OSV API query, see https://osv.dev/docs/#tag/api/operation/OSV_QueryAffected.
+type osvQuery struct {
+	Package AffectedPackage `json:"package"`
+	Version string          `json:"version"`
+}
+
+type osvQueryResponse struct {
+	Vulns []json.RawMessage `json:"vulns"`
+}
+
+// Serves vulnerability database in format which govulncheck
+// understands. Every file is available both as plain JSON and gzipped
+// (".json.gz"), latter is what govulncheck requests over HTTP.
+func vulndbGET(ec echo.Context) error {
+	if !settings.Bool("features.vulndb") {
+		return h.NotFoundGET(ec)
+	}
+
+	path := strings.TrimPrefix(ec.Request().URL.Path, vulndbPrefix)
+
+	var gzipped bool
+	if strings.HasSuffix(path, ".json.gz") {
+		gzipped = true
+		path = strings.TrimSuffix(path, ".gz")
+	}
+
+	var data interface{}
+	switch {
+	case path == "index/db.json":
+		data = buildDBIndex()
+	case path == "index/modules.json":
+		data = buildModulesIndex()
+	case path == "index/vulns.json":
+		data = buildVulnsIndex()
+	case strings.HasPrefix(path, "ID/") && strings.HasSuffix(path, ".json"):
+		vuln := GetVulnerabilityByID(strings.TrimSuffix(strings.TrimPrefix(path, "ID/"), ".json"))
+		if vuln == nil {
+			return h.NotFoundGET(ec)
+		}
+		data = json.RawMessage(vuln.Data)
+	default:
+		return h.NotFoundGET(ec)
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		log.Error().Msgf("Failed to encode vulnerability database's '%s': %s", path, err.Error())
+		return ec.NoContent(http.StatusInternalServerError)
+	}
+
+	if !gzipped {
+		return ec.JSONBlob(http.StatusOK, body)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write(body)
+	gz.Close()
+
+	return ec.Blob(http.StatusOK, "application/gzip", buf.Bytes())
+}
+
+// Answers OSV API-like queries: which advisories affect passed version
+// of passed module. Without version every not withdrawn advisory for
+// module is returned.
+func vulndbQueryPOST(ec echo.Context) error {
+	if !settings.Bool("features.vulndb") {
+		return h.NotFoundGET(ec)
+	}
+
+	query := &osvQuery{}
+	if err := json.NewDecoder(ec.Request().Body).Decode(query); err != nil {
+		return ec.JSON(http.StatusBadRequest, map[string]string{"message": "Invalid query: " + err.Error()})
+	}
+
+	if query.Package.Name == "" {
+		return ec.JSON(http.StatusBadRequest, map[string]string{"message": "Package name is required"})
+	}
+
+	if query.Package.Ecosystem != "" && query.Package.Ecosystem != EcosystemGo {
+		return ec.JSON(http.StatusOK, &osvQueryResponse{Vulns: []json.RawMessage{}})
+	}
+
+	resp := &osvQueryResponse{Vulns: []json.RawMessage{}}
+	for _, v := range GetVulnerabilitiesForModule(query.Package.Name) {
+		if v.Withdrawn {
+			continue
+		}
+
+		entry := v.Entry()
+		if entry == nil {
+			continue
+		}
+
+		if query.Version == "" || entry.Affects(query.Package.Name, query.Version) {
+			resp.Vulns = append(resp.Vulns, json.RawMessage(v.Data))
+		}
+	}
+
+	return ec.JSON(http.StatusOK, resp)
+}
+
+func buildDBIndex() *dbIndex {
+	index := &dbIndex{}
+	for _, v := range GetVulnerabilities() {
+		if v.Modified.After(index.Modified) {
+			index.Modified = v.Modified
+		}
+	}
+
+	return index
+}
+
+func buildModulesIndex() []*moduleIndexEntry {
+	modules := make(map[string]*moduleIndexEntry)
+	for _, v := range GetVulnerabilities() {
+		entry := v.Entry()
+		if entry == nil {
+			continue
+		}
+
+		for _, module := range entry.Modules() {
+			if modules[module] == nil {
+				modules[module] = &moduleIndexEntry{Path: module}
+			}
+
+			modules[module].Vulns = append(modules[module].Vulns, moduleIndexVulns{
+				ID:       v.ID,
+				Modified: v.Modified,
+				Fixed:    entry.LatestFixed(module),
+			})
+		}
+	}
+
+	index := make([]*moduleIndexEntry, 0, len(modules))
+	for _, m := range modules {
+		index = append(index, m)
+	}
+
+	sort.Slice(index, func(i, j int) bool {
+		return index[i].Path < index[j].Path
+	})
+
+	return index
+}
+
+func buildVulnsIndex() []*vulnIndexEntry {
+	index := []*vulnIndexEntry{}
+	for _, v := range GetVulnerabilities() {
+		entry := &vulnIndexEntry{ID: v.ID, Modified: v.Modified}
+		if v.Aliases != "" {
+			entry.Aliases = strings.Split(v.Aliases, ",")
+		}
+
+		index = append(index, entry)
+	}
+
+	return index
+}
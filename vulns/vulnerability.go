@@ -0,0 +1,209 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package vulns
+
+import (
+	// stdlib
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Vulnerability is an imported advisory.
+type Vulnerability struct {
+	ID         string    `db:"id"`
+	Aliases    string    `db:"aliases"`
+	Summary    string    `db:"summary"`
+	Withdrawn  bool      `db:"withdrawn"`
+	Source     string    `db:"source"`
+	Data       string    `db:"data"`
+	Modified   time.Time `db:"modified"`
+	Published  time.Time `db:"published"`
+	ImportedAt time.Time `db:"imported_at"`
+}
+
+// Results of saving advisory.
+const (
+	SaveCreated = iota
+	SaveUpdated
+	SaveSkipped
+)
+
+// GetVulnerabilities returns all imported advisories.
+func GetVulnerabilities() []*Vulnerability {
+	vulns := []*Vulnerability{}
+	err := database.DB.Select(&vulns, "SELECT * FROM `vulnerabilities` ORDER BY id")
+	if err != nil {
+		log.Error().Msgf("Failed to get vulnerabilities: %s", err.Error())
+		return nil
+	}
+
+	return vulns
+}
+
+// GetVulnerabilityByID returns advisory by it's ID.
+func GetVulnerabilityByID(id string) *Vulnerability {
+	vuln := &Vulnerability{}
+	err := database.DB.Get(vuln, database.DB.Rebind("SELECT * FROM `vulnerabilities` WHERE id=?"), id)
+	if err != nil {
+		log.Debug().Msgf("Failed to get vulnerability '%s': %s", id, err.Error())
+		return nil
+	}
+
+	return vuln
+}
+
+// GetVulnerabilitiesForModule returns all advisories which mention
+// module with passed path, including withdrawn ones.
+func GetVulnerabilitiesForModule(module string) []*Vulnerability {
+	vulns := []*Vulnerability{}
+	err := database.DB.Select(&vulns, database.DB.Rebind("SELECT v.* FROM `vulnerabilities` v JOIN `vulnerabilities_modules` vm ON vm.vulnerability_id=v.id WHERE vm.module=? ORDER BY v.id"), module)
+	if err != nil {
+		log.Error().Msgf("Failed to get vulnerabilities for module '%s': %s", module, err.Error())
+		return nil
+	}
+
+	return vulns
+}
+
+// GetAffectedModules returns map of advisory IDs to modules they affect.
+func GetAffectedModules() map[string][]string {
+	rows := []struct {
+		VulnerabilityID string `db:"vulnerability_id"`
+		Module          string `db:"module"`
+	}{}
+
+	err := database.DB.Select(&rows, "SELECT * FROM `vulnerabilities_modules` ORDER BY module")
+	if err != nil {
+		log.Error().Msgf("Failed to get modules affected by vulnerabilities: %s", err.Error())
+		return nil
+	}
+
+	modules := make(map[string][]string)
+	for _, row := range rows {
+		modules[row.VulnerabilityID] = append(modules[row.VulnerabilityID], row.Module)
+	}
+
+	return modules
+}
+
+// Entry returns parsed advisory.
+func (v *Vulnerability) Entry() *Entry {
+	entry, err := ParseEntry([]byte(v.Data))
+	if err != nil {
+		log.Error().Msgf("Failed to parse stored vulnerability '%s': %s", v.ID, err.Error())
+		return nil
+	}
+
+	return entry
+}
+
+// SaveEntry creates or updates advisory. Advisory is updated only if
+// passed one was modified later than stored.
+func SaveEntry(entry *Entry, data []byte, source string) (int, error) {
+	existing := GetVulnerabilityByID(entry.ID)
+	if existing != nil && !entry.Modified.UTC().After(existing.Modified) {
+		return SaveSkipped, nil
+	}
+
+	v := &Vulnerability{
+		ID:         entry.ID,
+		Aliases:    strings.Join(entry.Aliases, ","),
+		Summary:    entry.Summary,
+		Withdrawn:  entry.Withdrawn != nil,
+		Source:     source,
+		Data:       string(data),
+		Modified:   entry.Modified.UTC(),
+		Published:  entry.Published.UTC(),
+		ImportedAt: time.Now().UTC(),
+	}
+
+	// Summary column is limited, details are in data anyway.
+	if len(v.Summary) > 1024 {
+		v.Summary = v.Summary[:1021] + "..."
+	}
+
+	tx, err := database.DB.Beginx()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err1 := tx.Exec(tx.Rebind("DELETE FROM `vulnerabilities_modules` WHERE vulnerability_id=?"), v.ID); err1 != nil {
+		tx.Rollback()
+		return 0, err1
+	}
+
+	if _, err2 := tx.Exec(tx.Rebind("DELETE FROM `vulnerabilities` WHERE id=?"), v.ID); err2 != nil {
+		tx.Rollback()
+		return 0, err2
+	}
+
+	if _, err3 := tx.NamedExec("INSERT INTO `vulnerabilities` (id, aliases, summary, withdrawn, source, data, modified, published, imported_at) VALUES (:id, :aliases, :summary, :withdrawn, :source, :data, :modified, :published, :imported_at)", v); err3 != nil {
+		tx.Rollback()
+		return 0, err3
+	}
+
+	for _, module := range entry.Modules() {
+		if _, err4 := tx.Exec(tx.Rebind("INSERT INTO `vulnerabilities_modules` (vulnerability_id, module) VALUES (?, ?)"), v.ID, module); err4 != nil {
+			tx.Rollback()
+			return 0, err4
+		}
+	}
+
+	if err5 := tx.Commit(); err5 != nil {
+		return 0, err5
+	}
+
+	if existing != nil {
+		return SaveUpdated, nil
+	}
+
+	return SaveCreated, nil
+}
+
+// AffectingVersions returns advisories which affect any of passed
+// versions of module. Nil versions mean they aren't known, then every
+// not withdrawn advisory which mentions module is returned.
+func AffectingVersions(module string, versions []string) []*Entry {
+	var entries []*Entry
+	for _, v := range GetVulnerabilitiesForModule(module) {
+		if v.Withdrawn {
+			continue
+		}
+
+		entry := v.Entry()
+		if entry == nil {
+			continue
+		}
+
+		if versions == nil || len(entry.AffectedVersions(module, versions)) != 0 {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package webhooks
+
+import (
+	// stdlib
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Deliveries statuses.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Delivery is an event which is sent (or should be sent) to webhook.
+type Delivery struct {
+	ID            int       `db:"id"`
+	WebhookID     int       `db:"webhook_id"`
+	Event         string    `db:"event"`
+	Payload       string    `db:"payload"`
+	Status        string    `db:"status"`
+	Attempts      int       `db:"attempts"`
+	ResponseCode  int       `db:"response_code"`
+	Error         string    `db:"error"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// GetDeliveries returns latest deliveries, newest first. Zero webhook ID
+// means deliveries for all webhooks.
+func GetDeliveries(webhookID int, limit int) []*Delivery {
+	deliveries := []*Delivery{}
+
+	var err error
+	if webhookID == 0 {
+		err = database.DB.Select(&deliveries, database.DB.Rebind("SELECT * FROM `webhooks_deliveries` ORDER BY id DESC LIMIT ?"), limit)
+	} else {
+		err = database.DB.Select(&deliveries, database.DB.Rebind("SELECT * FROM `webhooks_deliveries` WHERE webhook_id=? ORDER BY id DESC LIMIT ?"), webhookID, limit)
+	}
+
+	if err != nil {
+		log.Error().Msgf("Failed to get webhooks deliveries: %s", err.Error())
+		return nil
+	}
+
+	return deliveries
+}
+
+// CountDeliveriesSince returns count of deliveries created since passed
+// time for every status.
+func CountDeliveriesSince(since time.Time) map[string]int {
+	rows := []struct {
+		Status string `db:"status"`
+		Count  int    `db:"count"`
+	}{}
+	err := database.DB.Select(&rows, database.DB.Rebind("SELECT status, COUNT(*) AS count FROM `webhooks_deliveries` WHERE created_at>=? GROUP BY status"), since)
+	if err != nil {
+		log.Error().Msgf("Failed to count webhooks deliveries: %s", err.Error())
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts
+}
+
+// Returns pending deliveries which should be attempted now.
+func getDueDeliveries(limit int) []*Delivery {
+	deliveries := []*Delivery{}
+	err := database.DB.Select(&deliveries, database.DB.Rebind("SELECT * FROM `webhooks_deliveries` WHERE status=? AND next_attempt_at<=? ORDER BY id LIMIT ?"), StatusPending, time.Now().UTC(), limit)
+	if err != nil {
+		log.Error().Msgf("Failed to get pending webhooks deliveries: %s", err.Error())
+		return nil
+	}
+
+	return deliveries
+}
+
+// Create inserts delivery into database.
+func (d *Delivery) Create() error {
+	now := time.Now().UTC()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	if d.NextAttemptAt.IsZero() {
+		d.NextAttemptAt = now
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `webhooks_deliveries` (webhook_id, event, payload, status, attempts, response_code, error, next_attempt_at, created_at, updated_at) VALUES (:webhook_id, :event, :payload, :status, :attempts, :response_code, :error, :next_attempt_at, :created_at, :updated_at)", d)
+	if err != nil {
+		return err
+	}
+
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+
+	d.ID = int(lastInsertedID)
+	return nil
+}
+
+// Save updates delivery's state in database.
+func (d *Delivery) Save() error {
+	d.UpdatedAt = time.Now().UTC()
+	if len(d.Error) > 1024 {
+		d.Error = d.Error[:1021] + "..."
+	}
+
+	_, err := database.DB.NamedExec("UPDATE `webhooks_deliveries` SET status=:status, attempts=:attempts, response_code=:response_code, error=:error, next_attempt_at=:next_attempt_at, updated_at=:updated_at WHERE id=:id", d)
+	return err
+}
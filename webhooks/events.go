@@ -0,0 +1,65 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package webhooks
+
+// Registry events which webhooks might subscribe to.
+const (
+	EventPackageCreated      = "package.created"
+	EventPackageUpdated      = "package.updated"
+	EventPackageDeleted      = "package.deleted"
+	EventPackageStateChanged = "package.state_changed"
+	EventPackageURLsChanged  = "package.urls_changed"
+	// Package's repository got new commits (reported by inbound hook).
+	EventPackagePushed = "package.pushed"
+	// Package's repository got new tag, i.e. new version appeared.
+	EventPackageTagged = "package.tagged"
+	// Module proxy snapshotted new version.
+	EventPackageVersionAdded = "package.version_added"
+	// Snapshotted version isn't available upstream anymore or differs
+	// from snapshot.
+	EventPackageVersionDiscrepancy = "package.version_discrepancy"
+	// Sources URL (mirror) became unreachable.
+	EventPackageMirrorDown = "package.mirror_down"
+	// Sources URL (mirror) is reachable again.
+	EventPackageMirrorUp = "package.mirror_up"
+	// Sent only with "Send test event" button.
+	EventTest = "test"
+)
+
+// Events is a list of events which might be selected for webhook, with
+// descriptions.
+var Events = []struct {
+	Name        string
+	Description string
+}{
+	{EventPackageCreated, "Package was added"},
+	{EventPackageUpdated, "Package was changed"},
+	{EventPackageDeleted, "Package was deleted"},
+	{EventPackageStateChanged, "Package's lifecycle state was changed"},
+	{EventPackageURLsChanged, "Package's sources URLs or mirrors were changed"},
+	{EventPackagePushed, "Package's repository got new commits"},
+	{EventPackageTagged, "Package's repository got new tag (version)"},
+	{EventPackageVersionAdded, "Module proxy snapshotted package's new version"},
+	{EventPackageVersionDiscrepancy, "Package's snapshotted version vanished or changed upstream"},
+	{EventPackageMirrorDown, "Package's sources URL (mirror) became unreachable"},
+	{EventPackageMirrorUp, "Package's sources URL (mirror) is reachable again"},
+}
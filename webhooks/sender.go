@@ -0,0 +1,271 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package webhooks
+
+import (
+	// stdlib
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/common"
+	"github.com/welltrainedfolks/magister/internal/config"
+	"github.com/welltrainedfolks/magister/internal/settings"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// How many times delivery is attempted before it is considered
+	// failed.
+	maxAttempts = 6
+	// Delay before second attempt, doubled for every next one.
+	initialRetryDelay = 30 * time.Second
+	// Request timeout.
+	deliveryTimeout = 10 * time.Second
+	// How often pending deliveries are checked.
+	workerInterval = 10 * time.Second
+)
+
+// Payload is what is POSTed to webhooks.
+type Payload struct {
+	// Unique event ID, same for all webhooks which receive event.
+	ID        string    `json:"id"`
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	// MAGISTER instance which sent event (http.domain from
+	// configuration) and it's version.
+	Instance        string `json:"instance"`
+	MagisterVersion string `json:"magister_version"`
+	// Event-specific data.
+	Data interface{} `json:"data"`
+}
+
+var (
+	httpClient = &http.Client{Timeout: deliveryTimeout}
+	// Wakes worker up when new deliveries were queued. Stays nil when
+	// worker isn't running (e.g. in magisterctl), then deliveries are
+	// sent by MAGISTER server.
+	wakeup chan struct{}
+)
+
+// Emit queues event for every enabled webhook which is subscribed to
+// it. Nothing is queued if outgoing webhooks are disabled.
+func Emit(event string, data interface{}) {
+	if !settings.Bool("features.outgoing_webhooks") {
+		return
+	}
+
+	hooks := GetWebhooks()
+	if len(hooks) == 0 {
+		return
+	}
+
+	payload, err := buildPayload(event, data)
+	if err != nil {
+		log.Error().Msgf("Failed to build payload for event '%s': %s", event, err.Error())
+		return
+	}
+
+	var queued bool
+	for _, hook := range hooks {
+		if !hook.IsSubscribedTo(event) {
+			continue
+		}
+
+		d := &Delivery{WebhookID: hook.ID, Event: event, Payload: payload, Status: StatusPending}
+		if err1 := d.Create(); err1 != nil {
+			log.Error().Msgf("Failed to queue event '%s' for webhook '%s': %s", event, hook.Name, err1.Error())
+			continue
+		}
+
+		queued = true
+	}
+
+	if queued && wakeup != nil {
+		select {
+		case wakeup <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SendTest sends test event to webhook immediately and returns
+// delivery with result. Failed test delivery isn't retried.
+func SendTest(hook *Webhook) (*Delivery, error) {
+	payload, err := buildPayload(EventTest, map[string]string{"webhook": hook.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Delivery{WebhookID: hook.ID, Event: EventTest, Payload: payload, Status: StatusPending, Attempts: maxAttempts - 1}
+	if err1 := d.Create(); err1 != nil {
+		return nil, err1
+	}
+
+	attempt(hook, d)
+	return d, nil
+}
+
+func buildPayload(event string, data interface{}) (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	p := &Payload{
+		ID:              hex.EncodeToString(id),
+		Event:           event,
+		CreatedAt:       time.Now().UTC(),
+		Instance:        config.Config.HTTP.Domain,
+		MagisterVersion: common.VERSION,
+		Data:            data,
+	}
+
+	encoded, err1 := json.Marshal(p)
+	if err1 != nil {
+		return "", err1
+	}
+
+	return string(encoded), nil
+}
+
+// Sign returns "sha256=" prefixed hex HMAC-SHA256 of payload, which is
+// sent in X-Magister-Signature-256 header.
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Attempts delivery and saves result. Unsuccessful delivery is retried
+// later with exponential backoff until attempts are exhausted.
+func attempt(hook *Webhook, d *Delivery) {
+	d.Attempts++
+	d.ResponseCode = 0
+	d.Error = ""
+
+	err := send(hook, d)
+	switch {
+	case err == nil:
+		d.Status = StatusDelivered
+	case d.Attempts >= maxAttempts:
+		d.Status = StatusFailed
+		d.Error = err.Error()
+	default:
+		d.Error = err.Error()
+		d.NextAttemptAt = time.Now().UTC().Add(initialRetryDelay << uint(d.Attempts-1))
+	}
+
+	if d.Status != StatusDelivered {
+		log.Warn().Msgf("Failed to deliver event '%s' to webhook '%s' (attempt %d): %s", d.Event, hook.Name, d.Attempts, d.Error)
+	}
+
+	if err1 := d.Save(); err1 != nil {
+		log.Error().Msgf("Failed to save webhook delivery #%d: %s", d.ID, err1.Error())
+	}
+}
+
+func send(hook *Webhook, d *Delivery) error {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewBufferString(d.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MAGISTER/"+common.VERSION)
+	req.Header.Set("X-Magister-Event", d.Event)
+	req.Header.Set("X-Magister-Delivery", strconv.Itoa(d.ID))
+	if hook.Secret != "" {
+		req.Header.Set("X-Magister-Signature-256", Sign([]byte(d.Payload), hook.Secret))
+	}
+
+	resp, err1 := httpClient.Do(req)
+	if err1 != nil {
+		return err1
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	d.ResponseCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &statusError{code: resp.StatusCode}
+	}
+
+	return nil
+}
+
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return "unexpected response status " + strconv.Itoa(e.code) + " " + http.StatusText(e.code)
+}
+
+// Sends pending deliveries in background.
+func startWorker() {
+	wakeup = make(chan struct{}, 1)
+
+	go func() {
+		ticker := time.NewTicker(workerInterval)
+		for {
+			processDueDeliveries()
+
+			select {
+			case <-ticker.C:
+			case <-wakeup:
+			}
+		}
+	}()
+}
+
+func processDueDeliveries() {
+	hooks := make(map[int]*Webhook)
+
+	for _, d := range getDueDeliveries(50) {
+		hook, found := hooks[d.WebhookID]
+		if !found {
+			hook = GetWebhookByID(d.WebhookID)
+			hooks[d.WebhookID] = hook
+		}
+
+		if hook == nil || !hook.Enabled {
+			d.Status = StatusFailed
+			d.Error = "webhook was deleted or disabled"
+			d.Save()
+			continue
+		}
+
+		attempt(hook, d)
+	}
+}
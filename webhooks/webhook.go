@@ -0,0 +1,162 @@
+// Copyright (c) 2018, Well Trained Folks and contributors.
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package webhooks
+
+import (
+	// stdlib
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	// local
+	"github.com/welltrainedfolks/magister/internal/database"
+
+	// other
+	"github.com/rs/zerolog/log"
+)
+
+// Webhook describes where and which events should be sent.
+type Webhook struct {
+	ID     int    `db:"id"`
+	Name   string `db:"name"`
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+	// Comma-separated events list, "*" means all events.
+	Events    string    `db:"events"`
+	Enabled   bool      `db:"enabled"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// GetWebhooks returns all webhooks.
+func GetWebhooks() []*Webhook {
+	hooks := []*Webhook{}
+	err := database.DB.Select(&hooks, "SELECT * FROM `webhooks` ORDER BY id")
+	if err != nil {
+		log.Error().Msgf("Failed to get webhooks: %s", err.Error())
+		return nil
+	}
+
+	return hooks
+}
+
+// GetWebhookByID returns webhook by it's ID.
+func GetWebhookByID(id int) *Webhook {
+	hook := &Webhook{}
+	err := database.DB.Get(hook, database.DB.Rebind("SELECT * FROM `webhooks` WHERE id=?"), id)
+	if err != nil {
+		log.Error().Msgf("Failed to get webhook with id '%d': %s", id, err.Error())
+		return nil
+	}
+
+	return hook
+}
+
+// Validate checks webhook's data.
+func (w *Webhook) Validate() error {
+	if w.Name == "" {
+		return errors.New("name should not be empty")
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("URL should be absolute HTTP or HTTPS URL")
+	}
+
+	if len(w.EventsList()) == 0 {
+		return errors.New("at least one event should be selected")
+	}
+
+	return nil
+}
+
+// Create inserts webhook into database.
+func (w *Webhook) Create() error {
+	if err := w.Validate(); err != nil {
+		return err
+	}
+
+	if w.CreatedAt.IsZero() {
+		w.CreatedAt = time.Now().UTC()
+	}
+
+	res, err := database.DB.NamedExec("INSERT INTO `webhooks` (name, url, secret, events, enabled, created_at) VALUES (:name, :url, :secret, :events, :enabled, :created_at)", w)
+	if err != nil {
+		return err
+	}
+
+	lastInsertedID, err1 := res.LastInsertId()
+	if err1 != nil {
+		return err1
+	}
+
+	w.ID = int(lastInsertedID)
+	return nil
+}
+
+// Save updates webhook in database.
+func (w *Webhook) Save() error {
+	if err := w.Validate(); err != nil {
+		return err
+	}
+
+	_, err := database.DB.NamedExec("UPDATE `webhooks` SET name=:name, url=:url, secret=:secret, events=:events, enabled=:enabled WHERE id=:id", w)
+	return err
+}
+
+// Delete deletes webhook with it's deliveries.
+func (w *Webhook) Delete() error {
+	if _, err := database.DB.NamedExec("DELETE FROM `webhooks_deliveries` WHERE webhook_id=:id", w); err != nil {
+		return err
+	}
+
+	_, err1 := database.DB.NamedExec("DELETE FROM `webhooks` WHERE id=:id", w)
+	return err1
+}
+
+// EventsList returns list of events webhook is subscribed to.
+func (w *Webhook) EventsList() []string {
+	var events []string
+	for _, event := range strings.Split(w.Events, ",") {
+		event = strings.TrimSpace(event)
+		if event != "" {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// IsSubscribedTo returns true if webhook should receive passed event.
+func (w *Webhook) IsSubscribedTo(event string) bool {
+	if !w.Enabled {
+		return false
+	}
+
+	for _, e := range w.EventsList() {
+		if e == "*" || e == event {
+			return true
+		}
+	}
+
+	return false
+}